@@ -0,0 +1,11 @@
+data "personio_absence_balances" "example" {
+  # employee_id = 13649297 # optional, all employees if not set
+}
+
+locals {
+  # employees carrying over more than 5 days of paid vacation
+  carry_over_employee_ids = toset([
+    for b in data.personio_absence_balances.example.balances : b.employee_id
+    if b.category == "paid_vacation" && b.balance > 5
+  ])
+}
@@ -0,0 +1,14 @@
+data "personio_absences" "example" {
+  start_date = "2024-08-01"
+  end_date   = "2024-08-31"
+
+  employee_ids      = [12345, 67890] # optional
+  time_off_type_ids = [2179197]      # optional, see personio_time_off_types
+}
+
+locals {
+  # employees with an approved absence in the period
+  absent_employee_ids = toset([
+    for a in data.personio_absences.example.absences : a.employee_id if a.status == "approved"
+  ])
+}
@@ -0,0 +1,10 @@
+data "personio_attendance_projects" "example" {
+  active = true # optional
+}
+
+locals {
+  # project IDs by name, e.g. to keep the projects of other systems in sync
+  attendance_project_ids = {
+    for p in data.personio_attendance_projects.example.projects : p.name => p.id
+  }
+}
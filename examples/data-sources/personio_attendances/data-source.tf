@@ -0,0 +1,14 @@
+data "personio_attendances" "example" {
+  start_date = "2024-08-01"
+  end_date   = "2024-08-31"
+
+  employee_ids    = [12345, 67890] # optional
+  include_pending = true           # optional
+}
+
+locals {
+  # attendance records per project, e.g. to reconcile against billing
+  attendances_by_project = {
+    for a in data.personio_attendances.example.attendances : a.project_id => a... if a.project_id != null
+  }
+}
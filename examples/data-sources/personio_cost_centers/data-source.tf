@@ -0,0 +1,8 @@
+data "personio_cost_centers" "example" {
+  status = ["active"] # optional, counts all employees if not set
+}
+
+# e.g. look up the cost center ID by name to tag cloud accounts
+locals {
+  cost_center_ids = { for cc in data.personio_cost_centers.example.cost_centers : cc.name => cc.id }
+}
@@ -0,0 +1,15 @@
+data "personio_custom_report" "example" {
+  report_id = "c8a5e3f0-7d1b-4b52-9a6e-2f4d8b1c0e93"
+}
+
+locals {
+  # column labels by column ID
+  report_labels = {
+    for c in data.personio_custom_report.example.columns : c.id => c.label
+  }
+
+  # the email addresses of all employees in the report
+  report_emails = [
+    for r in data.personio_custom_report.example.rows : r["email"] if r["email"] != null
+  ]
+}
@@ -0,0 +1,8 @@
+data "personio_departments" "example" {
+  status = ["active", "onboarding"] # optional, counts all employees if not set
+}
+
+# e.g. one chat channel per department
+locals {
+  departments = { for d in data.personio_departments.example.departments : d.id => d }
+}
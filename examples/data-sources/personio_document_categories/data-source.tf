@@ -0,0 +1,12 @@
+# all document categories
+data "personio_document_categories" "all" {
+}
+
+# look up a single category by its name
+data "personio_document_categories" "contracts" {
+  name = "Contracts"
+}
+
+output "contracts_category_id" {
+  value = data.personio_document_categories.contracts.category_id
+}
@@ -1,3 +1,30 @@
 data "personio_employee" "example" {
   id = 12345 # The Personio employee ID to load. Fails if it does not exist
 }
+
+# Look up an employee by email address
+data "personio_employee" "by_email" {
+  email = "jane.doe@example.com"
+}
+
+# Look up an employee by the value of a dynamic attribute, e.g. a HR system ID.
+# Fails if no employee or more than one employee matches.
+data "personio_employee" "by_attribute" {
+  match {
+    attribute = "Employee ID" # key (e.g. dynamic_123456) or label
+    value     = "45"
+  }
+}
+
+# Resolve the supervisor and the supervisor's supervisor into full employee records
+data "personio_employee" "with_supervisors" {
+  email = "jane.doe@example.com"
+
+  expand_supervisor_depth = 2
+}
+
+locals {
+  # e.g. for approval workflows
+  approver_position   = data.personio_employee.with_supervisors.employee.supervisor_chain[0].hr_info.position
+  approver_department = data.personio_employee.with_supervisors.employee.supervisor_chain[0].profile.department
+}
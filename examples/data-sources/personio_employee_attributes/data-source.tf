@@ -0,0 +1,10 @@
+data "personio_employee_attributes" "example" {
+  # loads all employee attributes readable by the API credential
+}
+
+locals {
+  # look up the key of a dynamic attribute by its label
+  employee_id_attribute = one([
+    for a in data.personio_employee_attributes.example.attributes : a.key if a.label == "Employee ID"
+  ])
+}
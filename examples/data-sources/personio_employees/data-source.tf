@@ -1,3 +1,17 @@
 data "personio_employees" "example" {
   # loads all employees
 }
+
+data "personio_employees" "example_filtered" {
+  status        = ["active", "onboarding"]
+  department_id = 123456
+  updated_since = "2024-01-31" # sent to the Personio API
+
+  dynamic_attribute {
+    attribute = "First Aider" # the dynamic attribute key or label
+    value     = "yes"
+  }
+
+  sort_by = "last_name"
+  limit   = 10
+}
@@ -0,0 +1,12 @@
+data "personio_holiday_calendars" "example" {
+  status = ["active"] # optional, counts all employees if not set
+}
+
+locals {
+  # country and state of the public holidays per employee
+  holiday_region_by_employee = merge([
+    for c in data.personio_holiday_calendars.example.holiday_calendars : {
+      for id in c.employee_ids : id => { country = c.country, state = c.state }
+    }
+  ]...)
+}
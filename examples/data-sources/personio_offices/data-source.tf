@@ -0,0 +1,8 @@
+data "personio_offices" "example" {
+  status = ["active"] # optional, counts all employees if not set
+}
+
+# e.g. one badge system group per office, keyed by the stable office ID
+locals {
+  offices = { for o in data.personio_offices.example.offices : o.id => o }
+}
@@ -0,0 +1,17 @@
+data "personio_org_chart" "example" {
+  root_employee_id = 12345 # optional, only return this employee and its reports
+}
+
+locals {
+  org_chart = {
+    for e in data.personio_org_chart.example.employees : e.employee_id => e
+  }
+
+  # everyone in the chain of command of an employee, e.g. for approvals
+  approvers = local.org_chart[67890].manager_chain
+
+  # managers with more than 10 direct or indirect reports
+  large_org_managers = [
+    for e in data.personio_org_chart.example.employees : e.employee_id if length(e.all_reports) > 10
+  ]
+}
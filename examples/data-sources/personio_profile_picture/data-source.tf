@@ -0,0 +1,12 @@
+data "personio_profile_picture" "example" {
+  employee_id = 123456
+  width       = 256 # optional, in pixels
+}
+
+locals {
+  # data URI that can be handed to systems accepting inline avatars,
+  # null if the employee has no picture
+  avatar = (data.personio_profile_picture.example.exists ?
+    "data:${data.personio_profile_picture.example.content_type};base64,${data.personio_profile_picture.example.content_base64}"
+  : null)
+}
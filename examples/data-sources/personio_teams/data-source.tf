@@ -0,0 +1,14 @@
+data "personio_teams" "example" {
+  department_id = 4090747    # optional, only counts employees of this department
+  status        = ["active"] # optional, counts all employees if not set
+}
+
+# e.g. one GitHub team per Personio team, maintained by the team lead
+locals {
+  teams = {
+    for t in data.personio_teams.example.teams : t.name => {
+      members    = t.employee_emails
+      maintainer = try(t.lead.email, null)
+    }
+  }
+}
@@ -0,0 +1,9 @@
+data "personio_time_off_types" "example" {
+}
+
+locals {
+  # look up the ID of a time-off type by its category
+  paid_vacation_id = one([
+    for t in data.personio_time_off_types.example.time_off_types : t.id if t.category == "paid_vacation"
+  ])
+}
@@ -0,0 +1,12 @@
+data "personio_work_schedules" "example" {
+  status = ["active"] # optional, counts all employees if not set
+}
+
+locals {
+  # weekly working hours per employee, e.g. for a time-tracking integration
+  weekly_hours_by_employee = merge([
+    for s in data.personio_work_schedules.example.work_schedules : {
+      for id in s.employee_ids : id => s.weekly_hours
+    }
+  ]...)
+}
@@ -0,0 +1,155 @@
+package adapter
+
+import (
+	"context"
+	"net/url"
+	"slices"
+	"strconv"
+	"time"
+
+	personio "github.com/giantswarm/personio-go/v1"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/nicoangelo/terraform-provider-personio/internal/utils"
+)
+
+const absencesPath = "/company/time-offs"
+
+type Absence struct {
+	Id           types.Int64         `tfsdk:"id"`
+	EmployeeId   types.Int64         `tfsdk:"employee_id"`
+	Status       types.String        `tfsdk:"status"`
+	Comment      types.String        `tfsdk:"comment"`
+	StartDate    types.String        `tfsdk:"start_date"`
+	EndDate      types.String        `tfsdk:"end_date"`
+	HalfDayStart types.Bool          `tfsdk:"half_day_start"`
+	HalfDayEnd   types.Bool          `tfsdk:"half_day_end"`
+	DaysCount    types.Float64       `tfsdk:"days_count"`
+	TimeOffType  *AbsenceTimeOffType `tfsdk:"time_off_type"`
+	CreatedAt    types.String        `tfsdk:"created_at"`
+	UpdatedAt    types.String        `tfsdk:"updated_at"`
+}
+
+type AbsenceTimeOffType struct {
+	Id       types.Int64  `tfsdk:"id"`
+	Name     types.String `tfsdk:"name"`
+	Category types.String `tfsdk:"category"`
+}
+
+// AbsenceFilter selects the absences to return. Absences that overlap
+// with the period from StartDate to EndDate are returned.
+type AbsenceFilter struct {
+	StartDate      time.Time
+	EndDate        time.Time
+	EmployeeIds    []int64
+	TimeOffTypeIds []int64
+}
+
+func (f AbsenceFilter) query() url.Values {
+	q := url.Values{}
+	q.Set("start_date", f.StartDate.Format(utils.DateFormat))
+	q.Set("end_date", f.EndDate.Format(utils.DateFormat))
+	for _, id := range f.EmployeeIds {
+		q.Add("employees[]", strconv.FormatInt(id, 10))
+	}
+	for _, id := range f.TimeOffTypeIds {
+		q.Add("time_off_types[]", strconv.FormatInt(id, 10))
+	}
+	return q
+}
+
+// apiAbsence is an item of the time-offs endpoint.
+type apiAbsence struct {
+	Attributes struct {
+		Id           int64                 `json:"id"`
+		Status       *string               `json:"status"`
+		Comment      *string               `json:"comment"`
+		StartDate    *string               `json:"start_date"`
+		EndDate      *string               `json:"end_date"`
+		DaysCount    *float64              `json:"days_count"`
+		HalfDayStart personio.PersonioBool `json:"half_day_start"`
+		HalfDayEnd   personio.PersonioBool `json:"half_day_end"`
+		TimeOffType  *struct {
+			Attributes struct {
+				Id       int64   `json:"id"`
+				Name     string  `json:"name"`
+				Category *string `json:"category"`
+			} `json:"attributes"`
+		} `json:"time_off_type"`
+		Employee  *personio.Employee `json:"employee"`
+		CreatedAt *string            `json:"created_at"`
+		UpdatedAt *string            `json:"updated_at"`
+	} `json:"attributes"`
+}
+
+func NewAbsence(a apiAbsence) Absence {
+	attrs := a.Attributes
+	res := Absence{
+		Id:           types.Int64Value(attrs.Id),
+		EmployeeId:   types.Int64Null(),
+		Status:       types.StringPointerValue(attrs.Status),
+		Comment:      types.StringPointerValue(attrs.Comment),
+		StartDate:    convertToDate(attrs.StartDate),
+		EndDate:      convertToDate(attrs.EndDate),
+		HalfDayStart: types.BoolValue(bool(attrs.HalfDayStart)),
+		HalfDayEnd:   types.BoolValue(bool(attrs.HalfDayEnd)),
+		DaysCount:    types.Float64PointerValue(attrs.DaysCount),
+		CreatedAt:    convertToTimestamp(attrs.CreatedAt),
+		UpdatedAt:    convertToTimestamp(attrs.UpdatedAt),
+	}
+	if attrs.Employee != nil {
+		if id, ok := employeeId(attrs.Employee); ok {
+			res.EmployeeId = types.Int64Value(id)
+		}
+	}
+	if t := attrs.TimeOffType; t != nil {
+		res.TimeOffType = &AbsenceTimeOffType{
+			Id:       types.Int64Value(t.Attributes.Id),
+			Name:     types.StringValue(t.Attributes.Name),
+			Category: types.StringPointerValue(t.Attributes.Category),
+		}
+	}
+	return res
+}
+
+// apply filters the absences by time-off type on the client side as well,
+// so that the filter holds even if the API ignores the parameter.
+func (f AbsenceFilter) apply(absences []Absence) []Absence {
+	if len(f.TimeOffTypeIds) == 0 {
+		return absences
+	}
+	var res []Absence
+	for _, a := range absences {
+		if a.TimeOffType != nil && slices.Contains(f.TimeOffTypeIds, a.TimeOffType.Id.ValueInt64()) {
+			res = append(res, a)
+		}
+	}
+	return res
+}
+
+// GetAbsences returns the absences that pass the filter, following the pagination of the API.
+// The time-offs endpoint counts its offset in pages rather than items.
+func (p *PersonioAdapter) GetAbsences(ctx context.Context, filter AbsenceFilter) (absences []Absence, err error) {
+	query := filter.query()
+	apiAbsences, err := cached(p.cache, absencesPath+"?"+query.Encode(), func() ([]apiAbsence, error) {
+		items, err := p.client.getPages(ctx, absencesPath, query, offsetByPage)
+		if err != nil {
+			return nil, err
+		}
+		res := make([]apiAbsence, 0, len(items))
+		for _, item := range items {
+			var a apiAbsence
+			if err := decodeJson(item, &a); err != nil {
+				return nil, err
+			}
+			res = append(res, a)
+		}
+		return res, nil
+	})
+	if err != nil {
+		return absences, err
+	}
+	for _, a := range apiAbsences {
+		absences = append(absences, NewAbsence(a))
+	}
+	return filter.apply(absences), nil
+}
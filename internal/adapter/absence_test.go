@@ -0,0 +1,121 @@
+package adapter
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/jesse0michael/go-rest-assured/assured"
+)
+
+// absencesPage returns a time-offs response with count absences of the
+// time-off type typeId, starting at ID first.
+func absencesPage(first int, count int, typeId int) []byte {
+	data := make([]map[string]any, 0, count)
+	for i := 0; i < count; i++ {
+		data = append(data, map[string]any{
+			"type": "TimeOffPeriod",
+			"attributes": map[string]any{
+				"id":             first + i,
+				"status":         "approved",
+				"start_date":     "2024-08-01T00:00:00+02:00",
+				"end_date":       "2024-08-01T00:00:00+02:00",
+				"days_count":     1,
+				"half_day_start": 0,
+				"half_day_end":   0,
+				"time_off_type": map[string]any{
+					"type":       "TimeOffType",
+					"attributes": map[string]any{"id": typeId, "name": fmt.Sprintf("Type %d", typeId)},
+				},
+			},
+		})
+	}
+	body, _ := json.Marshal(map[string]any{"success": true, "data": data})
+	return body
+}
+
+func TestGetAbsencesFollowsPagination(t *testing.T) {
+	c := restServerWith(assured.Call{
+		Path:       "/company/time-offs",
+		Method:     "GET",
+		StatusCode: 200,
+		Response:   absencesPage(1, pagingMaxLimit, 1),
+	}, assured.Call{
+		Path:       "/company/time-offs",
+		Method:     "GET",
+		StatusCode: 200,
+		Response:   absencesPage(1+pagingMaxLimit, 1, 1),
+	})
+	defer c.Close()
+
+	p := testAdapter(t, c, DefaultAdapterOptions())
+	absences, err := p.GetAbsences(context.Background(), AbsenceFilter{
+		StartDate:   time.Date(2024, 8, 1, 0, 0, 0, 0, time.UTC),
+		EndDate:     time.Date(2024, 8, 31, 0, 0, 0, 0, time.UTC),
+		EmployeeIds: []int64{testEmployeeId},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(absences) != pagingMaxLimit+1 {
+		t.Fatalf("expected %d absences, got %d", pagingMaxLimit+1, len(absences))
+	}
+	if got := absences[pagingMaxLimit].Id.ValueInt64(); got != pagingMaxLimit+1 {
+		t.Errorf("expected last absence to have ID %d, got %d", pagingMaxLimit+1, got)
+	}
+
+	calls, err := c.Verify("GET", "company/time-offs")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(calls) != 2 {
+		t.Fatalf("expected 2 requests, got %d", len(calls))
+	}
+	// the time-offs endpoint counts the offset in pages
+	for i, call := range calls {
+		if want := fmt.Sprint(i); call.Query["offset"] != want {
+			t.Errorf("expected offset %s in request %d, got %s", want, i, call.Query["offset"])
+		}
+		if call.Query["start_date"] != "2024-08-01" || call.Query["end_date"] != "2024-08-31" {
+			t.Errorf("expected the period in request %d, got %v", i, call.Query)
+		}
+	}
+}
+
+func TestGetAbsencesFiltersTimeOffTypes(t *testing.T) {
+	var data []json.RawMessage
+	for i, typeId := range []int{1, 2, 1, 3} {
+		var page struct {
+			Data []json.RawMessage `json:"data"`
+		}
+		_ = json.Unmarshal(absencesPage(i+1, 1, typeId), &page)
+		data = append(data, page.Data...)
+	}
+	body, _ := json.Marshal(map[string]any{"success": true, "data": data})
+	c := restServerWith(assured.Call{
+		Path:       "/company/time-offs",
+		Method:     "GET",
+		StatusCode: 200,
+		Response:   body,
+	})
+	defer c.Close()
+
+	p := testAdapter(t, c, DefaultAdapterOptions())
+	absences, err := p.GetAbsences(context.Background(), AbsenceFilter{
+		StartDate:      time.Date(2024, 8, 1, 0, 0, 0, 0, time.UTC),
+		EndDate:        time.Date(2024, 8, 31, 0, 0, 0, 0, time.UTC),
+		TimeOffTypeIds: []int64{1, 3},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var ids []int64
+	for _, a := range absences {
+		ids = append(ids, a.Id.ValueInt64())
+	}
+	if fmt.Sprint(ids) != "[1 3 4]" {
+		t.Errorf("expected the absences of types 1 and 3, got IDs %v", ids)
+	}
+}
@@ -2,44 +2,155 @@ package adapter
 
 import (
 	"context"
+	"fmt"
+	"net/url"
+	"time"
 
 	personio "github.com/giantswarm/personio-go/v1"
 )
 
 const (
 	ApiBaseUrlDefault string = personio.DefaultBaseUrl
+
+	employeesCacheKey          = "/company/employees"
+	employeeAttributesCacheKey = "/company/employees/attributes"
+	timeOffTypesCacheKey       = "/company/time-off-types"
+	attendanceProjectsCacheKey = "/company/attendances/projects"
+	documentCategoriesCacheKey = "/company/document-categories"
 )
 
+// AdapterOptions tunes how the adapter talks to the Personio API.
+type AdapterOptions struct {
+	// MaxRetries is the number of times a throttled or failed request is retried.
+	MaxRetries int
+	// RetryMaxWait caps the time waited between two attempts.
+	RetryMaxWait time.Duration
+	// RequestsPerMinute limits the request rate on the client side. Zero means unlimited.
+	RequestsPerMinute int
+	// Cache controls whether API responses are shared between data sources.
+	Cache CacheMode
+}
+
+// DefaultAdapterOptions returns the options used when nothing else is configured.
+func DefaultAdapterOptions() AdapterOptions {
+	return AdapterOptions{
+		MaxRetries:   MaxRetriesDefault,
+		RetryMaxWait: RetryMaxWaitDefault,
+		Cache:        CacheModeDefault,
+	}
+}
+
 type PersonioAdapter struct {
-	Client *personio.Client
+	client *apiClient
+	cache  *responseCache
 }
 
-func NewAdapter(apiBaseUrl string, clientId string, clientSecret string) (*PersonioAdapter, error) {
+func NewAdapter(apiBaseUrl string, clientId string, clientSecret string, opts AdapterOptions) (*PersonioAdapter, error) {
+	if opts.MaxRetries < 0 {
+		return nil, fmt.Errorf("max retries must not be negative, got %d", opts.MaxRetries)
+	}
+	if opts.RetryMaxWait < 0 {
+		return nil, fmt.Errorf("retry max wait must not be negative, got %s", opts.RetryMaxWait)
+	}
 	credentials := personio.Credentials{ClientId: clientId, ClientSecret: clientSecret}
-	client, err := personio.NewClient(context.TODO(), apiBaseUrl, credentials)
 
-	if err == nil {
-		return &PersonioAdapter{
-			Client: client,
-		}, nil
-	}
-	return nil, err
+	return &PersonioAdapter{
+		client: newApiClient(apiBaseUrl, credentials, opts),
+		cache:  newResponseCache(opts.Cache),
+	}, nil
 }
 
-func (p *PersonioAdapter) GetEmployees() (employees []Employee, err error) {
-	pe, err := p.Client.GetEmployees()
-	if err != nil {
-		return employees, err
+// GetEmployees returns the employees that pass the filter. If all employees
+// have been fetched before, the filter is applied to that list.
+func (p *PersonioAdapter) GetEmployees(ctx context.Context, filter EmployeeFilter) (employees []Employee, err error) {
+	pes, ok := lookup[[]*personio.Employee](p.cache, employeesCacheKey)
+	if !ok {
+		pes, err = p.listEmployees(ctx, filter.query())
+		if err != nil {
+			return employees, err
+		}
 	}
-	for _, v := range pe {
-		employees = append(employees, NewEmployee(v))
+	for _, pe := range pes {
+		employees = append(employees, NewEmployee(pe))
 	}
-	return employees, nil
+	return filter.apply(employees), nil
 }
-func (p *PersonioAdapter) GetEmployee(id int64) (employee Employee, err error) {
-	pe, err := p.Client.GetEmployee(id)
+
+// GetEmployee returns a single employee. If all employees have been
+// fetched before, the employee is taken from that list.
+func (p *PersonioAdapter) GetEmployee(ctx context.Context, id int64) (employee Employee, err error) {
+	if pes, ok := lookup[[]*personio.Employee](p.cache, employeesCacheKey); ok {
+		for _, pe := range pes {
+			if peId, ok := employeeId(pe); ok && peId == id {
+				return NewEmployee(pe), nil
+			}
+		}
+	}
+
+	pe, err := cached(p.cache, fmt.Sprintf("%s/%d", employeesCacheKey, id), func() (*personio.Employee, error) {
+		body, err := p.client.get(ctx, fmt.Sprintf("/company/employees/%d", id), nil)
+		if err != nil {
+			return nil, err
+		}
+		var result struct {
+			Data personio.Employee `json:"data"`
+		}
+		if err := decodeJson(body, &result); err != nil {
+			return nil, err
+		}
+		return &result.Data, nil
+	})
 	if err != nil {
 		return employee, err
 	}
 	return NewEmployee(pe), nil
 }
+
+// listEmployees fetches the raw API representation of all employees
+// that match the query.
+func (p *PersonioAdapter) listEmployees(ctx context.Context, query url.Values) ([]*personio.Employee, error) {
+	key := employeesCacheKey
+	if len(query) > 0 {
+		key += "?" + query.Encode()
+	}
+	return cached(p.cache, key, func() ([]*personio.Employee, error) {
+		items, err := p.client.getPages(ctx, "/company/employees", query, offsetByItem)
+		if err != nil {
+			return nil, err
+		}
+		pes := make([]*personio.Employee, 0, len(items))
+		for _, item := range items {
+			var pe personio.Employee
+			if err := decodeJson(item, &pe); err != nil {
+				return nil, err
+			}
+			pes = append(pes, &pe)
+		}
+		return pes, nil
+	})
+}
+
+// GetEmployeeAttributes returns the employee attributes that
+// are defined in the tenant and readable by the API credential.
+func (p *PersonioAdapter) GetEmployeeAttributes(ctx context.Context) (attributes []EmployeeAttribute, err error) {
+	apiAttributes, err := cached(p.cache, employeeAttributesCacheKey, func() ([]apiEmployeeAttribute, error) {
+		body, err := p.client.get(ctx, "/company/employees/attributes", nil)
+		if err != nil {
+			return nil, err
+		}
+		var result struct {
+			Data []apiEmployeeAttribute `json:"data"`
+		}
+		if err := decodeJson(body, &result); err != nil {
+			return nil, err
+		}
+		return result.Data, nil
+	})
+	if err != nil {
+		return attributes, err
+	}
+	for _, a := range apiAttributes {
+		attributes = append(attributes, NewEmployeeAttribute(a))
+	}
+	return attributes, nil
+}
@@ -0,0 +1,154 @@
+package adapter
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/jesse0michael/go-rest-assured/assured"
+)
+
+const testEmployeeId = 13649297
+
+func restServerWith(endpoints ...assured.Call) *assured.Client {
+	c := assured.NewDefaultClient()
+	auth := assured.Call{
+		Path:       "/auth",
+		Method:     "POST",
+		StatusCode: 200,
+		Response:   []byte(`{"success": true, "data": { "token": "ghi" } }`),
+	}
+	// the mock server starts listening asynchronously
+	for i := 0; i < 50 && c.Given(auth) != nil; i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	for _, e := range endpoints {
+		c.Given(e)
+	}
+	return c
+}
+
+func testAdapter(t *testing.T, c *assured.Client, opts AdapterOptions) *PersonioAdapter {
+	t.Helper()
+	p, err := NewAdapter(c.URL(), "id", "secret", opts)
+	if err != nil {
+		t.Fatalf("unexpected error creating adapter: %s", err)
+	}
+	return p
+}
+
+func countCalls(t *testing.T, c *assured.Client, method string, path string) int {
+	t.Helper()
+	calls, err := c.Verify(method, path)
+	if err != nil {
+		t.Fatalf("unable to verify calls to %s: %s", path, err)
+	}
+	return len(calls)
+}
+
+func TestGetEmployeeRetriesThrottledRequests(t *testing.T) {
+	emp, _ := os.ReadFile("../../test/data/one_employee.json")
+	c := restServerWith(assured.Call{
+		Path:       "/company/employees/13649297",
+		Method:     "GET",
+		StatusCode: 429,
+		Headers:    map[string]string{"Retry-After": "1"},
+	}, assured.Call{
+		Path:       "/company/employees/13649297",
+		Method:     "GET",
+		StatusCode: 503,
+	}, assured.Call{
+		Path:       "/company/employees/13649297",
+		Method:     "GET",
+		StatusCode: 200,
+		Response:   emp,
+	})
+	defer c.Close()
+
+	p := testAdapter(t, c, AdapterOptions{MaxRetries: 2, RetryMaxWait: 10 * time.Millisecond})
+	e, err := p.GetEmployee(context.Background(), testEmployeeId)
+	if err != nil {
+		t.Fatalf("expected request to succeed after retries, got %s", err)
+	}
+	if got := e.Email.ValueString(); got != "na@example.com" {
+		t.Errorf("expected email na@example.com, got %s", got)
+	}
+	if got := countCalls(t, c, "GET", "company/employees/13649297"); got != 3 {
+		t.Errorf("expected 3 requests, got %d", got)
+	}
+}
+
+func TestGetEmployeeGivesUpAfterMaxRetries(t *testing.T) {
+	c := restServerWith(assured.Call{
+		Path:       "/company/employees/13649297",
+		Method:     "GET",
+		StatusCode: 429,
+	})
+	defer c.Close()
+
+	p := testAdapter(t, c, AdapterOptions{MaxRetries: 2, RetryMaxWait: time.Millisecond})
+	_, err := p.GetEmployee(context.Background(), testEmployeeId)
+	if err == nil || err.Error() != "429 Too Many Requests" {
+		t.Fatalf("expected 429 error, got %v", err)
+	}
+	if got := countCalls(t, c, "GET", "company/employees/13649297"); got != 3 {
+		t.Errorf("expected 3 requests, got %d", got)
+	}
+}
+
+func TestGetEmployeeDoesNotRetryClientErrors(t *testing.T) {
+	c := restServerWith(assured.Call{
+		Path:       "/company/employees/123",
+		Method:     "GET",
+		StatusCode: 404,
+	})
+	defer c.Close()
+
+	p := testAdapter(t, c, AdapterOptions{MaxRetries: 5, RetryMaxWait: time.Millisecond})
+	_, err := p.GetEmployee(context.Background(), 123)
+	if err == nil || err.Error() != "404 Not Found" {
+		t.Fatalf("expected 404 error, got %v", err)
+	}
+	if got := countCalls(t, c, "GET", "company/employees/123"); got != 1 {
+		t.Errorf("expected 1 request, got %d", got)
+	}
+}
+
+func TestRateLimiterSpacesRequests(t *testing.T) {
+	l := newRateLimiter(600)
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		if err := l.wait(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed < 200*time.Millisecond {
+		t.Errorf("expected requests to be spaced by 100ms, took %s for 3 requests", elapsed)
+	}
+}
+
+func TestRetryBackoffIsCapped(t *testing.T) {
+	p := retryPolicy{maxRetries: 10, maxWait: time.Second}
+	for attempt := 0; attempt < 40; attempt++ {
+		if d := p.backoff(attempt, nil); d > time.Second || d < 0 {
+			t.Errorf("attempt %d: backoff %s outside of [0, 1s]", attempt, d)
+		}
+	}
+}
+
+func TestRetryBackoffUsesRateLimitResetOnlyWhenThrottled(t *testing.T) {
+	p := retryPolicy{maxRetries: 10, maxWait: time.Hour}
+	header := http.Header{}
+	header.Set(headerRateLimitReset, "600")
+
+	throttled := &http.Response{StatusCode: http.StatusTooManyRequests, Header: header}
+	if d := p.backoff(0, throttled); d < 599*time.Second {
+		t.Errorf("expected to wait for the rate limit reset, got %s", d)
+	}
+	failed := &http.Response{StatusCode: http.StatusBadGateway, Header: header}
+	if d := p.backoff(0, failed); d > retryBaseWait {
+		t.Errorf("expected the usual backoff for a server error, got %s", d)
+	}
+}
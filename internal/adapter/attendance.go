@@ -0,0 +1,113 @@
+package adapter
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/nicoangelo/terraform-provider-personio/internal/utils"
+)
+
+const attendancesPath = "/company/attendances"
+
+type Attendance struct {
+	Id           types.Int64  `tfsdk:"id"`
+	EmployeeId   types.Int64  `tfsdk:"employee_id"`
+	Date         types.String `tfsdk:"date"`
+	StartTime    types.String `tfsdk:"start_time"`
+	EndTime      types.String `tfsdk:"end_time"`
+	BreakMinutes types.Int64  `tfsdk:"break_minutes"`
+	Comment      types.String `tfsdk:"comment"`
+	ProjectId    types.Int64  `tfsdk:"project_id"`
+	Status       types.String `tfsdk:"status"`
+	UpdatedAt    types.String `tfsdk:"updated_at"`
+}
+
+// AttendanceFilter selects the attendances to return. Attendances on the
+// days from StartDate to EndDate are returned.
+type AttendanceFilter struct {
+	StartDate      time.Time
+	EndDate        time.Time
+	EmployeeIds    []int64
+	IncludePending bool
+}
+
+func (f AttendanceFilter) query() url.Values {
+	q := url.Values{}
+	q.Set("start_date", f.StartDate.Format(utils.DateFormat))
+	q.Set("end_date", f.EndDate.Format(utils.DateFormat))
+	for _, id := range f.EmployeeIds {
+		q.Add("employees[]", strconv.FormatInt(id, 10))
+	}
+	if f.IncludePending {
+		q.Set("includePending", "true")
+	}
+	return q
+}
+
+// apiAttendance is an item of the attendances endpoint.
+type apiAttendance struct {
+	Id         int64 `json:"id"`
+	Attributes struct {
+		Employee  *int64  `json:"employee"`
+		Date      *string `json:"date"`
+		StartTime *string `json:"start_time"`
+		EndTime   *string `json:"end_time"`
+		Break     *int64  `json:"break"`
+		Comment   *string `json:"comment"`
+		Status    *string `json:"status"`
+		Project   *struct {
+			Id int64 `json:"id"`
+		} `json:"project"`
+		UpdatedAt *string `json:"updated_at"`
+	} `json:"attributes"`
+}
+
+func NewAttendance(a apiAttendance) Attendance {
+	attrs := a.Attributes
+	res := Attendance{
+		Id:           types.Int64Value(a.Id),
+		EmployeeId:   types.Int64PointerValue(attrs.Employee),
+		Date:         convertToDate(attrs.Date),
+		StartTime:    types.StringPointerValue(attrs.StartTime),
+		EndTime:      types.StringPointerValue(attrs.EndTime),
+		BreakMinutes: types.Int64PointerValue(attrs.Break),
+		Comment:      types.StringPointerValue(attrs.Comment),
+		ProjectId:    types.Int64Null(),
+		Status:       types.StringPointerValue(attrs.Status),
+		UpdatedAt:    convertToTimestamp(attrs.UpdatedAt),
+	}
+	if attrs.Project != nil {
+		res.ProjectId = types.Int64Value(attrs.Project.Id)
+	}
+	return res
+}
+
+// GetAttendances returns the attendances that pass the filter, following the pagination of the API.
+func (p *PersonioAdapter) GetAttendances(ctx context.Context, filter AttendanceFilter) (attendances []Attendance, err error) {
+	query := filter.query()
+	apiAttendances, err := cached(p.cache, attendancesPath+"?"+query.Encode(), func() ([]apiAttendance, error) {
+		items, err := p.client.getPages(ctx, attendancesPath, query, offsetByItem)
+		if err != nil {
+			return nil, err
+		}
+		res := make([]apiAttendance, 0, len(items))
+		for _, item := range items {
+			var a apiAttendance
+			if err := decodeJson(item, &a); err != nil {
+				return nil, err
+			}
+			res = append(res, a)
+		}
+		return res, nil
+	})
+	if err != nil {
+		return attendances, err
+	}
+	for _, a := range apiAttendances {
+		attendances = append(attendances, NewAttendance(a))
+	}
+	return attendances, nil
+}
@@ -0,0 +1,92 @@
+package adapter
+
+import (
+	"context"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// EmployeeAttribute describes an employee attribute that is defined in the
+// Personio tenant and readable by the API credential.
+type EmployeeAttribute struct {
+	Key         types.String   `tfsdk:"key"`
+	UniversalId types.String   `tfsdk:"universal_id"`
+	Label       types.String   `tfsdk:"label"`
+	Type        types.String   `tfsdk:"type"`
+	Options     []types.String `tfsdk:"options"`
+}
+
+// apiEmployeeAttribute is an item of the employee attributes endpoint.
+type apiEmployeeAttribute struct {
+	Key         string   `json:"key"`
+	Label       string   `json:"label"`
+	Type        string   `json:"type"`
+	UniversalId *string  `json:"universal_id"`
+	Options     []string `json:"options"`
+}
+
+func NewEmployeeAttribute(a apiEmployeeAttribute) EmployeeAttribute {
+	res := EmployeeAttribute{
+		Key:         types.StringValue(a.Key),
+		UniversalId: types.StringPointerValue(a.UniversalId),
+		Label:       types.StringValue(a.Label),
+		Type:        types.StringValue(a.Type),
+	}
+	// options only exist for attributes with a predefined list of values
+	if a.Type == "list" || a.Type == "tags" {
+		res.Options = make([]types.String, 0, len(a.Options))
+		for _, o := range a.Options {
+			res.Options = append(res.Options, types.StringValue(o))
+		}
+	}
+	return res
+}
+
+// AddAttributeOptions sets the allowed options of list and tags attributes
+// in the typed dynamic attributes of the employees and their supervisor
+// chains, taken from the attribute catalog. The catalog is only requested if
+// there are such attributes. If the catalog cannot be read, the options are
+// left null and the error is returned, so that callers may report it without
+// failing the read.
+func (p *PersonioAdapter) AddAttributeOptions(ctx context.Context, employees []Employee) error {
+	var typed []map[string]TypedAttribute
+	for _, e := range employees {
+		typed = append(typed, e.DynamicAttributesTyped)
+		for _, s := range e.SupervisorChain {
+			typed = append(typed, s.DynamicAttributesTyped)
+		}
+	}
+	if !slices.ContainsFunc(typed, hasAttributesWithOptions) {
+		return nil
+	}
+
+	attributes, err := p.GetEmployeeAttributes(ctx)
+	if err != nil {
+		return err
+	}
+	options := make(map[string][]types.String, len(attributes))
+	for _, a := range attributes {
+		if a.Options != nil {
+			options[a.Key.ValueString()] = a.Options
+		}
+	}
+	for _, attrs := range typed {
+		for k, v := range attrs {
+			if o, ok := options[k]; ok {
+				v.Options = o
+				attrs[k] = v
+			}
+		}
+	}
+	return nil
+}
+
+func hasAttributesWithOptions(attrs map[string]TypedAttribute) bool {
+	for _, v := range attrs {
+		if t := v.Type.ValueString(); t == "list" || t == "tags" {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,145 @@
+package adapter
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// absenceBalanceConcurrency is the number of employees whose absence
+// balances are fetched at the same time. It only bounds the requests in
+// flight: each of them still waits for the rate limiter of the client, which
+// spaces requests out to the configured requests per minute and holds them
+// back while the Personio rate limit is exhausted. More concurrency would
+// therefore not speed up a rate limited provider, it would only queue more
+// requests at the limiter.
+const absenceBalanceConcurrency = 4
+
+// AbsenceBalance is the balance of an employee for one time-off type.
+type AbsenceBalance struct {
+	EmployeeId    types.Int64   `tfsdk:"employee_id"`
+	TimeOffTypeId types.Int64   `tfsdk:"time_off_type_id"`
+	Name          types.String  `tfsdk:"name"`
+	Category      types.String  `tfsdk:"category"`
+	Balance       types.Float64 `tfsdk:"balance"`
+}
+
+// apiAbsenceBalance is an item of the absence balance endpoint.
+type apiAbsenceBalance struct {
+	Id       int64    `json:"id"`
+	Name     string   `json:"name"`
+	Category *string  `json:"category"`
+	Balance  *float64 `json:"balance"`
+}
+
+func NewAbsenceBalance(employeeId int64, b apiAbsenceBalance) AbsenceBalance {
+	return AbsenceBalance{
+		EmployeeId:    types.Int64Value(employeeId),
+		TimeOffTypeId: types.Int64Value(b.Id),
+		Name:          types.StringValue(b.Name),
+		Category:      types.StringPointerValue(b.Category),
+		Balance:       types.Float64PointerValue(b.Balance),
+	}
+}
+
+// GetAbsenceBalances returns the absence balances of an employee for all time-off types.
+func (p *PersonioAdapter) GetAbsenceBalances(ctx context.Context, employeeId int64) (balances []AbsenceBalance, err error) {
+	path := fmt.Sprintf("/company/employees/%d/absences/balance", employeeId)
+	apiBalances, err := cached(p.cache, path, func() ([]apiAbsenceBalance, error) {
+		body, err := p.client.get(ctx, path, nil)
+		if err != nil {
+			return nil, err
+		}
+		var result struct {
+			Data []apiAbsenceBalance `json:"data"`
+		}
+		if err := decodeJson(body, &result); err != nil {
+			return nil, err
+		}
+		return result.Data, nil
+	})
+	if err != nil {
+		return balances, err
+	}
+	for _, b := range apiBalances {
+		balances = append(balances, NewAbsenceBalance(employeeId, b))
+	}
+	return balances, nil
+}
+
+// EmployeeError is an error that concerns a single employee.
+type EmployeeError struct {
+	EmployeeId int64
+	Err        error
+}
+
+func (e EmployeeError) Error() string {
+	return fmt.Sprintf("employee %d: %s", e.EmployeeId, e.Err)
+}
+
+func (e EmployeeError) Unwrap() error {
+	return e.Err
+}
+
+// GetAbsenceBalancesOf returns the absence balances of several employees, in the
+// order of employeeIds. The balances are fetched with bounded concurrency.
+// Employees whose balances are refused by the API with a client error, e.g.
+// because the credential may not read them, are skipped and returned as
+// skipped. Any other error cancels the remaining requests.
+func (p *PersonioAdapter) GetAbsenceBalancesOf(ctx context.Context, employeeIds []int64) (balances []AbsenceBalance, skipped []EmployeeError, err error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	results := make([][]AbsenceBalance, len(employeeIds))
+	failures := make([]error, len(employeeIds))
+	var (
+		wg       sync.WaitGroup
+		errOnce  sync.Once
+		firstErr error
+	)
+	sem := make(chan struct{}, absenceBalanceConcurrency)
+	for i, id := range employeeIds {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+		wg.Add(1)
+		go func(i int, id int64) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			balances, err := p.GetAbsenceBalances(ctx, id)
+			if isRefused(err) {
+				failures[i] = err
+				return
+			}
+			if err != nil {
+				errOnce.Do(func() {
+					firstErr = EmployeeError{EmployeeId: id, Err: err}
+					cancel()
+				})
+				return
+			}
+			results[i] = balances
+		}(i, id)
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, nil, firstErr
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, nil, err
+	}
+	for i, r := range results {
+		if failures[i] != nil {
+			skipped = append(skipped, EmployeeError{EmployeeId: employeeIds[i], Err: failures[i]})
+		}
+		balances = append(balances, r...)
+	}
+	return balances, skipped, nil
+}
@@ -0,0 +1,100 @@
+package adapter
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jesse0michael/go-rest-assured/assured"
+)
+
+func TestGetAbsenceBalancesOfKeepsEmployeeOrder(t *testing.T) {
+	balances, _ := os.ReadFile("../../test/data/absence_balances.json")
+	employeeIds := []int64{13649297, 13649293, 13649290, 13649280, 13649261, 13649265}
+	var calls []assured.Call
+	for _, id := range employeeIds {
+		calls = append(calls, assured.Call{
+			Path:       fmt.Sprintf("/company/employees/%d/absences/balance", id),
+			Method:     "GET",
+			StatusCode: 200,
+			Response:   balances,
+		})
+	}
+	c := restServerWith(calls...)
+	defer c.Close()
+
+	p := testAdapter(t, c, DefaultAdapterOptions())
+	result, skipped, err := p.GetAbsenceBalancesOf(context.Background(), employeeIds)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(skipped) != 0 {
+		t.Fatalf("expected no skipped employees, got %v", skipped)
+	}
+	if len(result) != 3*len(employeeIds) {
+		t.Fatalf("expected %d balances, got %d", 3*len(employeeIds), len(result))
+	}
+	for i, b := range result {
+		if want := employeeIds[i/3]; b.EmployeeId.ValueInt64() != want {
+			t.Errorf("expected balance %d to belong to employee %d, got %d", i, want, b.EmployeeId.ValueInt64())
+		}
+	}
+}
+
+func TestGetAbsenceBalancesOfSkipsRefusedEmployee(t *testing.T) {
+	balances, _ := os.ReadFile("../../test/data/absence_balances.json")
+	c := restServerWith(assured.Call{
+		Path:       "/company/employees/13649297/absences/balance",
+		Method:     "GET",
+		StatusCode: 200,
+		Response:   balances,
+	}, assured.Call{
+		Path:       "/company/employees/13649293/absences/balance",
+		Method:     "GET",
+		StatusCode: 403,
+		Response:   []byte(`{"success": false, "error": {"code": 403, "message": "Forbidden"}}`),
+	})
+	defer c.Close()
+
+	p := testAdapter(t, c, AdapterOptions{RetryMaxWait: 10 * time.Millisecond})
+	result, skipped, err := p.GetAbsenceBalancesOf(context.Background(), []int64{13649297, 13649293})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result) != 3 || result[0].EmployeeId.ValueInt64() != 13649297 {
+		t.Errorf("expected the balances of employee 13649297, got %v", result)
+	}
+	if len(skipped) != 1 || skipped[0].EmployeeId != 13649293 {
+		t.Fatalf("expected employee 13649293 to be skipped, got %v", skipped)
+	}
+	if !strings.Contains(skipped[0].Error(), "employee 13649293") {
+		t.Errorf("expected the error to name the skipped employee, got %s", skipped[0])
+	}
+}
+
+func TestGetAbsenceBalancesOfReportsFailingEmployee(t *testing.T) {
+	balances, _ := os.ReadFile("../../test/data/absence_balances.json")
+	c := restServerWith(assured.Call{
+		Path:       "/company/employees/13649297/absences/balance",
+		Method:     "GET",
+		StatusCode: 200,
+		Response:   balances,
+	}, assured.Call{
+		Path:       "/company/employees/13649293/absences/balance",
+		Method:     "GET",
+		StatusCode: 502,
+	})
+	defer c.Close()
+
+	p := testAdapter(t, c, AdapterOptions{RetryMaxWait: 10 * time.Millisecond})
+	_, _, err := p.GetAbsenceBalancesOf(context.Background(), []int64{13649297, 13649293})
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	if !strings.Contains(err.Error(), "employee 13649293") {
+		t.Errorf("expected the error to name the failing employee, got %s", err)
+	}
+}
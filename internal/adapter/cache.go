@@ -0,0 +1,101 @@
+package adapter
+
+import (
+	"errors"
+	"sync"
+)
+
+type CacheMode string
+
+const (
+	// CacheModeRun keeps API responses for the lifetime of the provider instance,
+	// which is a single Terraform run.
+	CacheModeRun CacheMode = "run"
+	// CacheModeNone sends every request to the API.
+	CacheModeNone CacheMode = "none"
+
+	CacheModeDefault = CacheModeRun
+)
+
+// responseCache keeps the results of API requests by key, and lets concurrent
+// callers of the same key wait for a single request in flight.
+// Failed requests are not kept, so that a later caller tries again.
+type responseCache struct {
+	enabled bool
+
+	mu      sync.Mutex
+	entries map[string]*cacheEntry
+}
+
+// errFetchAborted is returned to the callers waiting for a request that
+// did not complete.
+var errFetchAborted = errors.New("request aborted")
+
+type cacheEntry struct {
+	done chan struct{}
+	val  any
+	err  error
+}
+
+func newResponseCache(mode CacheMode) *responseCache {
+	return &responseCache{
+		enabled: mode != CacheModeNone,
+		entries: map[string]*cacheEntry{},
+	}
+}
+
+// cached returns the value kept for key, waits for a request in flight for key,
+// or calls fetch and keeps its result.
+func cached[T any](c *responseCache, key string, fetch func() (T, error)) (T, error) {
+	if !c.enabled {
+		return fetch()
+	}
+
+	c.mu.Lock()
+	if e, ok := c.entries[key]; ok {
+		c.mu.Unlock()
+		<-e.done
+		if e.err != nil {
+			var zero T
+			return zero, e.err
+		}
+		return e.val.(T), nil
+	}
+	// fetch may panic: waiting callers then see errFetchAborted instead of
+	// blocking forever
+	e := &cacheEntry{done: make(chan struct{}), err: errFetchAborted}
+	c.entries[key] = e
+	c.mu.Unlock()
+	defer func() {
+		if e.err != nil {
+			c.mu.Lock()
+			delete(c.entries, key)
+			c.mu.Unlock()
+		}
+		close(e.done)
+	}()
+
+	val, err := fetch()
+	e.val, e.err = val, err
+	return val, err
+}
+
+// lookup returns the value kept for key without fetching it. A request
+// in flight for key is waited for. Missing keys and failed requests are
+// reported as not ok.
+func lookup[T any](c *responseCache, key string) (val T, ok bool) {
+	if !c.enabled {
+		return val, false
+	}
+	c.mu.Lock()
+	e, found := c.entries[key]
+	c.mu.Unlock()
+	if !found {
+		return val, false
+	}
+	<-e.done
+	if e.err != nil {
+		return val, false
+	}
+	return e.val.(T), true
+}
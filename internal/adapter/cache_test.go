@@ -0,0 +1,238 @@
+package adapter
+
+import (
+	"context"
+	"errors"
+	"os"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/jesse0michael/go-rest-assured/assured"
+)
+
+func employeesServer(t *testing.T, delay int) *assured.Client {
+	t.Helper()
+	emps, _ := os.ReadFile("../../test/data/all_employees.json")
+	emp, _ := os.ReadFile("../../test/data/one_employee.json")
+	return restServerWith(assured.Call{
+		Path:       "/company/employees",
+		Method:     "GET",
+		StatusCode: 200,
+		Response:   emps,
+		Delay:      delay,
+	}, assured.Call{
+		Path:       "/company/employees/13649297",
+		Method:     "GET",
+		StatusCode: 200,
+		Response:   emp,
+		Delay:      delay,
+	})
+}
+
+func TestCachedEmployeeLookupsAreAnsweredFromList(t *testing.T) {
+	c := employeesServer(t, 0)
+	defer c.Close()
+	p := testAdapter(t, c, DefaultAdapterOptions())
+	ctx := context.Background()
+
+	if _, err := p.GetEmployees(ctx, EmployeeFilter{}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := p.GetEmployees(ctx, EmployeeFilter{}); err != nil {
+		t.Fatal(err)
+	}
+	for _, id := range []int64{13649297, 13649293, 13649290} {
+		e, err := p.GetEmployee(ctx, id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := e.Id.ValueInt64(); got != id {
+			t.Errorf("expected employee %d, got %d", id, got)
+		}
+	}
+
+	if got := countCalls(t, c, "GET", "company/employees"); got != 1 {
+		t.Errorf("expected 1 request for all employees, got %d", got)
+	}
+	if got := countCalls(t, c, "GET", "company/employees/13649297"); got != 0 {
+		t.Errorf("expected no request for a single employee, got %d", got)
+	}
+}
+
+func TestCachedEmployeeIsNotShared(t *testing.T) {
+	c := employeesServer(t, 0)
+	defer c.Close()
+	p := testAdapter(t, c, DefaultAdapterOptions())
+	ctx := context.Background()
+
+	e, err := p.GetEmployee(ctx, testEmployeeId)
+	if err != nil {
+		t.Fatal(err)
+	}
+	e.DynamicAttributes["dynamic_7124008"] = e.Email
+
+	e, err = p.GetEmployee(ctx, testEmployeeId)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := e.DynamicAttributes["dynamic_7124008"].ValueString(); got != "+41446681800" {
+		t.Errorf("expected unmodified attribute, got %s", got)
+	}
+	if got := countCalls(t, c, "GET", "company/employees/13649297"); got != 1 {
+		t.Errorf("expected 1 request for a single employee, got %d", got)
+	}
+}
+
+func TestCachedConcurrentRequestsAreCoalesced(t *testing.T) {
+	cache := newResponseCache(CacheModeRun)
+	started, release := make(chan struct{}), make(chan struct{})
+	var fetches atomic.Int32
+	fetch := func() (string, error) {
+		fetches.Add(1)
+		return "value", nil
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		_, _ = cached(cache, "key", func() (string, error) {
+			close(started)
+			<-release
+			return fetch()
+		})
+	}()
+	// the request is in flight from here on, until it is released
+	<-started
+	for i := 0; i < 5; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			if got, err := cached(cache, "key", fetch); err != nil || got != "value" {
+				t.Errorf("expected the value of the request in flight, got %q, %v", got, err)
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			if got, ok := lookup[string](cache, "key"); !ok || got != "value" {
+				t.Errorf("expected to look up the value of the request in flight, got %q", got)
+			}
+		}()
+	}
+	close(release)
+	wg.Wait()
+
+	if got := fetches.Load(); got != 1 {
+		t.Errorf("expected 1 request, got %d", got)
+	}
+}
+
+func TestCachedReleasesWaitersWhenFetchPanics(t *testing.T) {
+	cache := newResponseCache(CacheModeRun)
+	started, release := make(chan struct{}), make(chan struct{})
+
+	panicked := make(chan any)
+	go func() {
+		defer func() { panicked <- recover() }()
+		_, _ = cached(cache, "key", func() (string, error) {
+			close(started)
+			<-release
+			panic("fetch failed")
+		})
+	}()
+	<-started
+
+	waited := make(chan error)
+	go func() {
+		_, err := cached(cache, "key", func() (string, error) {
+			return "", errors.New("fetched again")
+		})
+		waited <- err
+	}()
+	close(release)
+
+	if r := <-panicked; r == nil {
+		t.Error("expected the panic to reach the caller that fetched")
+	}
+	if err := <-waited; err == nil {
+		t.Error("expected an error for the waiting caller")
+	}
+	if _, ok := lookup[string](cache, "key"); ok {
+		t.Error("expected the aborted request not to be kept")
+	}
+}
+
+func TestCacheModeNoneSendsEveryRequest(t *testing.T) {
+	c := employeesServer(t, 0)
+	defer c.Close()
+	opts := DefaultAdapterOptions()
+	opts.Cache = CacheModeNone
+	p := testAdapter(t, c, opts)
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		if _, err := p.GetEmployees(ctx, EmployeeFilter{}); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := p.GetEmployee(ctx, testEmployeeId); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if got := countCalls(t, c, "GET", "company/employees"); got != 2 {
+		t.Errorf("expected 2 requests for all employees, got %d", got)
+	}
+	if got := countCalls(t, c, "GET", "company/employees/13649297"); got != 2 {
+		t.Errorf("expected 2 requests for a single employee, got %d", got)
+	}
+}
+
+func TestCacheDoesNotKeepErrors(t *testing.T) {
+	c := restServerWith(assured.Call{
+		Path:       "/company/employees/123",
+		Method:     "GET",
+		StatusCode: 404,
+	})
+	defer c.Close()
+	p := testAdapter(t, c, DefaultAdapterOptions())
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		if _, err := p.GetEmployee(ctx, 123); err == nil {
+			t.Fatal("expected an error")
+		}
+	}
+	if got := countCalls(t, c, "GET", "company/employees/123"); got != 2 {
+		t.Errorf("expected 2 requests, got %d", got)
+	}
+}
+
+func TestCachedListIsFilteredInMemory(t *testing.T) {
+	c := employeesServer(t, 0)
+	defer c.Close()
+	p := testAdapter(t, c, DefaultAdapterOptions())
+	ctx := context.Background()
+
+	if _, err := p.GetEmployees(ctx, EmployeeFilter{}); err != nil {
+		t.Fatal(err)
+	}
+	employees, err := p.GetEmployees(ctx, EmployeeFilter{Email: "na@example.com"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(employees) != 1 {
+		t.Errorf("expected 1 employee, got %d", len(employees))
+	}
+
+	calls, err := c.Verify("GET", "company/employees")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(calls) != 1 {
+		t.Fatalf("expected 1 request for all employees, got %d", len(calls))
+	}
+	if _, ok := calls[0].Query["email"]; ok {
+		t.Errorf("expected the email filter to be applied in memory")
+	}
+}
@@ -0,0 +1,307 @@
+package adapter
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	personio "github.com/giantswarm/personio-go/v1"
+)
+
+const (
+	pagingMaxLimit = 100
+	requestTimeout = 40 * time.Second
+)
+
+// resultBody is the envelope around every JSON document returned by the Personio API v1.
+type resultBody struct {
+	Success bool `json:"success"`
+	Error   struct {
+		Code    int    `json:"code,omitempty"`
+		Message string `json:"message,omitempty"`
+	} `json:"error,omitempty"`
+}
+
+// pageResult is the response body of pageable endpoints.
+type pageResult struct {
+	Data []json.RawMessage `json:"data"`
+}
+
+// apiClient talks to the Personio API v1. It takes care of authentication,
+// client-side rate limiting and retrying of throttled or failed requests.
+// It is safe for concurrent use.
+type apiClient struct {
+	baseUrl     string
+	credentials personio.Credentials
+	http        *http.Client
+	limiter     *rateLimiter
+	retry       retryPolicy
+
+	// Personio rotates the access token with every response. A token that is
+	// handed back by the API is parked here until the next request takes it.
+	tokenMu sync.Mutex
+	token   string
+}
+
+func newApiClient(baseUrl string, credentials personio.Credentials, opts AdapterOptions) *apiClient {
+	if baseUrl == "" {
+		baseUrl = ApiBaseUrlDefault
+	}
+	return &apiClient{
+		baseUrl:     strings.TrimSuffix(baseUrl, "/"),
+		credentials: credentials,
+		http:        &http.Client{Timeout: requestTimeout},
+		limiter:     newRateLimiter(opts.RequestsPerMinute),
+		retry: retryPolicy{
+			maxRetries: opts.MaxRetries,
+			maxWait:    opts.RetryMaxWait,
+		},
+	}
+}
+
+// get fetches a single JSON document from the given path and returns the raw body.
+func (c *apiClient) get(ctx context.Context, path string, query url.Values) ([]byte, error) {
+	return c.doJson(ctx, http.MethodGet, path, query, true)
+}
+
+// pagingMode is the unit in which an endpoint counts the offset parameter.
+// Most endpoints skip offset items, but some count pages instead.
+type pagingMode int
+
+const (
+	// offsetByItem skips offset items. Used by most endpoints.
+	offsetByItem pagingMode = iota
+	// offsetByPage starts at the zero-based page number offset.
+	// Used by /company/time-offs.
+	offsetByPage
+)
+
+// getPages follows the limit/offset pagination of an endpoint and returns
+// all objects of all pages as individual raw messages. The paging mode must
+// match the endpoint, otherwise objects are skipped or returned twice.
+func (c *apiClient) getPages(ctx context.Context, path string, query url.Values, mode pagingMode) ([]json.RawMessage, error) {
+	var items []json.RawMessage
+	for {
+		pageQuery := url.Values{}
+		for k, v := range query {
+			pageQuery[k] = v
+		}
+		pageQuery.Set("limit", strconv.Itoa(pagingMaxLimit))
+		offset := len(items)
+		if mode == offsetByPage {
+			offset /= pagingMaxLimit
+		}
+		pageQuery.Set("offset", strconv.Itoa(offset))
+
+		body, err := c.get(ctx, path, pageQuery)
+		if err != nil {
+			return nil, err
+		}
+
+		var page pageResult
+		if err := json.Unmarshal(body, &page); err != nil {
+			return nil, err
+		}
+		items = append(items, page.Data...)
+
+		if len(page.Data) < pagingMaxLimit {
+			return items, nil
+		}
+	}
+}
+
+// authenticate exchanges the client credentials for a fresh access token.
+func (c *apiClient) authenticate(ctx context.Context) (string, error) {
+	form := url.Values{}
+	form.Add("client_id", c.credentials.ClientId)
+	form.Add("client_secret", c.credentials.ClientSecret)
+
+	body, _, err := c.do(ctx, func() (*http.Request, error) {
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseUrl+"/auth", strings.NewReader(form.Encode()))
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.Header.Set("Accept", "application/json")
+		return req, nil
+	}, false)
+	if err != nil {
+		return "", err
+	}
+	if err := checkResult(body); err != nil {
+		return "", err
+	}
+
+	var auth personio.Auth
+	if err := json.Unmarshal(body, &auth); err != nil {
+		return "", err
+	}
+	return auth.Data.Token, nil
+}
+
+// doJson sends a request that expects a JSON response and verifies
+// the success flag of the response envelope.
+func (c *apiClient) doJson(ctx context.Context, method string, path string, query url.Values, useAuthentication bool) ([]byte, error) {
+	body, _, err := c.do(ctx, func() (*http.Request, error) {
+		req, err := http.NewRequestWithContext(ctx, method, c.baseUrl+path, nil)
+		if err != nil {
+			return nil, err
+		}
+		req.URL.RawQuery = query.Encode()
+		req.Header.Set("Accept", "application/json")
+		return req, nil
+	}, useAuthentication)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkResult(body); err != nil {
+		return nil, err
+	}
+	return body, nil
+}
+
+// getBinary fetches a non-JSON document, such as an image, from the given
+// path and returns the raw body together with its content type.
+func (c *apiClient) getBinary(ctx context.Context, path string, accept string) ([]byte, string, error) {
+	body, header, err := c.do(ctx, func() (*http.Request, error) {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseUrl+path, nil)
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Accept", accept)
+		return req, nil
+	}, true)
+	if err != nil {
+		return nil, "", err
+	}
+	return body, header.Get("Content-Type"), nil
+}
+
+// do sends the request built by newRequest and retries it according to the
+// retry policy. A new request is built for every attempt, so that request
+// bodies can be replayed. The headers of the successful response are
+// returned alongside its body.
+func (c *apiClient) do(ctx context.Context, newRequest func() (*http.Request, error), useAuthentication bool) ([]byte, http.Header, error) {
+	for attempt := 0; ; attempt++ {
+		req, err := newRequest()
+		if err != nil {
+			return nil, nil, err
+		}
+		if useAuthentication {
+			token, err := c.takeToken(ctx)
+			if err != nil {
+				return nil, nil, err
+			}
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+
+		if err := c.limiter.wait(ctx); err != nil {
+			return nil, nil, err
+		}
+
+		body, resp, err := c.send(req)
+		if resp != nil {
+			c.limiter.observe(resp.Header)
+			if useAuthentication {
+				c.parkToken(resp.Header)
+			}
+		}
+		if err == nil {
+			return body, resp.Header, nil
+		}
+
+		if !c.retry.shouldRetry(attempt, resp, err) {
+			return nil, nil, err
+		}
+		if err := sleep(ctx, c.retry.backoff(attempt, resp)); err != nil {
+			return nil, nil, err
+		}
+	}
+}
+
+// send executes a single HTTP round trip. The response is returned alongside
+// non-2xx status errors so that callers can inspect its headers.
+func (c *apiClient) send(req *http.Request) ([]byte, *http.Response, error) {
+	resp, err := c.http.Do(req)
+	if err != nil {
+		// preserve the error of a cancelled context
+		if ctxErr := req.Context().Err(); ctxErr != nil {
+			return nil, nil, ctxErr
+		}
+		return nil, nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		// drain the body to allow connection reuse
+		_, _ = io.Copy(io.Discard, resp.Body)
+		return nil, resp, personio.StatusError{Err: errors.New(resp.Status), Code: resp.StatusCode}
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, resp, err
+	}
+	return body, resp, nil
+}
+
+// isRefused reports whether err is a client error response of the API, e.g.
+// because the credential may not read an object or it does not exist.
+// Throttled requests are not refused, they failed after all retries.
+func isRefused(err error) bool {
+	var statusErr personio.StatusError
+	return errors.As(err, &statusErr) && statusErr.Code >= 400 && statusErr.Code < 500 && statusErr.Code != http.StatusTooManyRequests
+}
+
+// takeToken returns a parked access token, or authenticates if there is none.
+func (c *apiClient) takeToken(ctx context.Context) (string, error) {
+	c.tokenMu.Lock()
+	token := c.token
+	c.token = ""
+	c.tokenMu.Unlock()
+
+	if token != "" {
+		return token, nil
+	}
+	return c.authenticate(ctx)
+}
+
+// parkToken stores the rotated access token returned with a response, if any.
+func (c *apiClient) parkToken(header http.Header) {
+	token := strings.TrimPrefix(header.Get("Authorization"), "Bearer ")
+	if token == "" {
+		return
+	}
+	c.tokenMu.Lock()
+	c.token = token
+	c.tokenMu.Unlock()
+}
+
+// decodeJson decodes an API response body into v. Numbers in untyped values
+// are decoded as json.Number, so that large integers such as IDs stay exact.
+func decodeJson(data []byte, v any) error {
+	d := json.NewDecoder(bytes.NewReader(data))
+	d.UseNumber()
+	return d.Decode(v)
+}
+
+// checkResult verifies the success flag of a Personio response envelope.
+func checkResult(body []byte) error {
+	var result resultBody
+	if err := json.Unmarshal(body, &result); err != nil {
+		return err
+	}
+	if !result.Success {
+		return fmt.Errorf("personio returned error: code=%d, message=%s", result.Error.Code, result.Error.Message)
+	}
+	return nil
+}
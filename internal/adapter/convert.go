@@ -1,14 +1,21 @@
 package adapter
 
 import (
+	"encoding/json"
 	"fmt"
 	"math/big"
+	"strconv"
 	"time"
 
 	personio "github.com/giantswarm/personio-go/v1"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/nicoangelo/terraform-provider-personio/internal/utils"
 )
 
+// bigFloatPrecision is the mantissa size of numbers, enough to represent
+// all 64 bit integers exactly.
+const bigFloatPrecision = 128
+
 // convertAnyAttrToString inspects the underlying type returned from the Personio API
 // and uses an appropriate conversion mechanism to convert it to a string.
 // Conventions:
@@ -22,12 +29,12 @@ func convertAnyAttrToString(v personio.Attribute) types.String {
 	}
 	switch v.Type {
 	case "integer":
-		intVal, ok := v.Value.(int64)
+		intVal, ok := numberToInt64(v.Value)
 		if ok {
 			return types.StringValue(fmt.Sprint(intVal))
 		}
 	case "decimal":
-		decVal, ok := v.Value.(float64)
+		decVal, ok := numberToFloat64(v.Value)
 		if ok {
 			return types.StringValue(fmt.Sprint(decVal))
 		}
@@ -39,6 +46,45 @@ func convertAnyAttrToString(v personio.Attribute) types.String {
 	return types.StringNull()
 }
 
+// convertAnyAttrToTyped converts a dynamic API value to a TypedAttribute,
+// keeping the Personio type of the value.
+// Conventions:
+//   - integer, decimal: number_value
+//   - standard, multiline, link, list: string_value
+//   - date: string_value in RFC3339 format and date_value with the calendar date,
+//     both in the timezone given by the API, so that they agree on the day
+//   - tags: set_value
+//
+// The allowed options of list and tags attributes are not part of the value.
+// They are added by PersonioAdapter.AddAttributeOptions.
+func convertAnyAttrToTyped(v personio.Attribute) TypedAttribute {
+	res := TypedAttribute{
+		Type:        types.StringValue(v.Type),
+		StringValue: types.StringNull(),
+		NumberValue: types.NumberNull(),
+	}
+	switch v.Type {
+	case "integer", "decimal":
+		res.NumberValue = convertAttrToNumber(v)
+	case "standard", "multiline", "link", "list":
+		res.StringValue = convertAttrToString(v)
+	case "date":
+		if t := v.GetTimeValue(); t != nil {
+			res.StringValue = types.StringValue(t.Format(time.RFC3339))
+			res.DateValue = &DateValue{
+				Year:  types.Int64Value(int64(t.Year())),
+				Month: types.Int64Value(int64(t.Month())),
+				Day:   types.Int64Value(int64(t.Day())),
+			}
+		}
+	case "tags":
+		if v.Value != nil {
+			res.SetValue = convertTagsToStrings(v)
+		}
+	}
+	return res
+}
+
 func convertTagsToStrings(v personio.Attribute) (res []types.String) {
 	if v.Value == nil {
 		return []types.String{}
@@ -63,26 +109,39 @@ func convertAttrToString(v personio.Attribute) types.String {
 	return types.StringNull()
 }
 
-// convertAttrToNumber converts an integer API value
+// convertAttrToNumber converts an integer or decimal API value
 // to a Terraform Number value. If the value is null, types.NumberNull is returned.
 func convertAttrToNumber(v personio.Attribute) types.Number {
 	if v.Value == nil {
 		return types.NumberNull()
 	}
-	intVal, ok := v.Value.(float64)
+	numVal, ok := numberToBigFloat(v.Value)
 	if ok {
-		return types.NumberValue(big.NewFloat(intVal))
+		return types.NumberValue(numVal)
 	}
 	return types.NumberNull()
 }
 
+// convertAttrToInt converts an integer API value (e.g. an ID)
+// to a Terraform Int64 value. If the value is null, types.Int64Null is returned.
+func convertAttrToInt(v personio.Attribute) types.Int64 {
+	if v.Value == nil {
+		return types.Int64Null()
+	}
+	intVal, ok := numberToInt64(v.Value)
+	if ok {
+		return types.Int64Value(intVal)
+	}
+	return types.Int64Null()
+}
+
 // convertAttrToFloat converts a decimal API value
 // to a Terraform Float64 value. If the value is null, types.Float64Null is returned.
 func convertAttrToFloat(v personio.Attribute) types.Float64 {
 	if v.Value == nil {
 		return types.Float64Null()
 	}
-	decVal, ok := v.Value.(float64)
+	decVal, ok := numberToFloat64(v.Value)
 	if ok {
 		return types.Float64Value(decVal)
 	}
@@ -102,6 +161,34 @@ func convertAttrToDateString(v personio.Attribute) types.String {
 	return types.StringNull()
 }
 
+// convertToDate converts an RFC3339 timestamp or a date of a typed API value to
+// a Terraform String value with the calendar date (YYYY-MM-DD) in the timezone
+// given by the API. If the value is null or invalid, types.StringNull is returned.
+func convertToDate(v *string) types.String {
+	if v == nil {
+		return types.StringNull()
+	}
+	t, err := utils.ParseTimeOrDate(*v)
+	if err != nil {
+		return types.StringNull()
+	}
+	return types.StringValue(t.Format(utils.DateFormat))
+}
+
+// convertToTimestamp converts an RFC3339 timestamp of a typed API value to a
+// Terraform String value in UTC timezone. If the value is null or invalid,
+// types.StringNull is returned.
+func convertToTimestamp(v *string) types.String {
+	if v == nil {
+		return types.StringNull()
+	}
+	t, err := time.Parse(time.RFC3339, *v)
+	if err != nil {
+		return types.StringNull()
+	}
+	return types.StringValue(t.UTC().Format(time.RFC3339))
+}
+
 // convertMapItemToString converts a specific attribute of a nested map API value (e.g. supervisor)
 // to a Terraform String value. If the value is null, types.StringNull is returned.
 func convertMapItemToString(v personio.Attribute, itemKey string) types.String {
@@ -123,20 +210,53 @@ func convertMapItemToInt(v personio.Attribute, itemKey string) types.Int64 {
 		return types.Int64Null()
 	}
 	mapVal := v.GetMapValue()
-	intVal, ok := mapVal[itemKey].(float64)
+	intVal, ok := numberToInt64(mapVal[itemKey])
 	if ok {
-		return types.Int64Value(int64(intVal))
+		return types.Int64Value(intVal)
 	}
 	return types.Int64Null()
 }
 
+// convertMapItemToFloat converts a specific attribute of a nested map API value (e.g. cost center)
+// to a Terraform Float64 value. If the value is null, types.Float64Null is returned.
+func convertMapItemToFloat(v personio.Attribute, itemKey string) types.Float64 {
+	if v.Value == nil {
+		return types.Float64Null()
+	}
+	mapVal := v.GetMapValue()
+	floatVal, ok := numberToFloat64(mapVal[itemKey])
+	if ok {
+		return types.Float64Value(floatVal)
+	}
+	return types.Float64Null()
+}
+
+// convertListToAttributes splits a list API value of nested objects (e.g. cost centers)
+// into one attribute per object, so that the convertMapItemTo* functions can be
+// used on the items. If the value is null, nil is returned.
+func convertListToAttributes(v personio.Attribute) []personio.Attribute {
+	listVal, ok := v.Value.([]interface{})
+	if !ok {
+		return nil
+	}
+	res := make([]personio.Attribute, 0, len(listVal))
+	for _, item := range listVal {
+		res = append(res, personio.Attribute{Type: "standard", Value: item})
+	}
+	return res
+}
+
 // convertNestedMapItemToString converts a specific attribute of a nested map API value (e.g. supervisor)
-// to a Terraform String value. If the value is null, types.StringNull is returned.
+// to a Terraform String value. If the value or the nested item is null or has an unexpected
+// shape, types.StringNull is returned.
 func convertNestedMapItemToString(v personio.Attribute, itemKey string) types.String {
 	if v.Value == nil {
 		return types.StringNull()
 	}
-	mapVal := v.GetMapValue()[itemKey].(map[string]interface{})
+	mapVal, ok := v.GetMapValue()[itemKey].(map[string]interface{})
+	if !ok {
+		return types.StringNull()
+	}
 	strVal, ok := mapVal["value"].(string)
 	if ok {
 		return types.StringValue(strVal)
@@ -144,17 +264,81 @@ func convertNestedMapItemToString(v personio.Attribute, itemKey string) types.St
 	return types.StringNull()
 }
 
-// convertNestedMapItemToNumber converts a specific attribute of a nested map API value (e.g. supervisor)
-// to a Terraform number value. If the value is null, types.NumberNull is returned.
-func convertNestedMapItemToNumber(v personio.Attribute, itemKey string) types.Number {
+// convertNestedMapItemToInt converts a specific attribute of a nested map API value (e.g. supervisor)
+// to a Terraform Int64 value. If the value or the nested item is null or has an unexpected
+// shape, types.Int64Null is returned.
+func convertNestedMapItemToInt(v personio.Attribute, itemKey string) types.Int64 {
 	if v.Value == nil {
-		return types.NumberNull()
+		return types.Int64Null()
+	}
+	mapVal, ok := v.GetMapValue()[itemKey].(map[string]interface{})
+	if !ok {
+		return types.Int64Null()
 	}
-	mapVal := v.GetMapValue()[itemKey].(map[string]interface{})
-	// nested numbers are stored as float
-	floatVal, ok := mapVal["value"].(float64)
+	intVal, ok := numberToInt64(mapVal["value"])
 	if ok {
-		return types.NumberValue(big.NewFloat(floatVal))
+		return types.Int64Value(intVal)
 	}
-	return types.NumberNull()
+	return types.Int64Null()
+}
+
+// employeeId returns the Personio ID of an API employee object.
+func employeeId(pe *personio.Employee) (int64, bool) {
+	return numberToInt64(pe.Attributes["id"].Value)
+}
+
+// jsonNumber returns a numeric API value as json.Number. API responses are
+// decoded with json.Number to keep large integers exact, but float64 values
+// are accepted as well.
+func jsonNumber(v any) (json.Number, bool) {
+	switch n := v.(type) {
+	case json.Number:
+		return n, true
+	case float64:
+		return json.Number(strconv.FormatFloat(n, 'f', -1, 64)), true
+	}
+	return "", false
+}
+
+// numberToInt64 converts a numeric API value to an int64 without loss of
+// precision. Integral numbers in another notation, such as 5.0 or 1e3, are
+// accepted. Numbers with a fractional part or outside of the int64 range
+// are rejected.
+func numberToInt64(v any) (int64, bool) {
+	n, ok := jsonNumber(v)
+	if !ok {
+		return 0, false
+	}
+	if intVal, err := n.Int64(); err == nil {
+		return intVal, true
+	}
+	bigVal, ok := numberToBigFloat(n)
+	if !ok || !bigVal.IsInt() {
+		return 0, false
+	}
+	intVal, accuracy := bigVal.Int64()
+	if accuracy != big.Exact {
+		return 0, false
+	}
+	return intVal, true
+}
+
+func numberToFloat64(v any) (float64, bool) {
+	n, ok := jsonNumber(v)
+	if !ok {
+		return 0, false
+	}
+	floatVal, err := n.Float64()
+	return floatVal, err == nil
+}
+
+// numberToBigFloat converts a numeric API value to a big.Float that
+// represents integers exactly.
+func numberToBigFloat(v any) (*big.Float, bool) {
+	n, ok := jsonNumber(v)
+	if !ok {
+		return nil, false
+	}
+	bigVal, _, err := big.ParseFloat(n.String(), 10, bigFloatPrecision, big.ToNearestEven)
+	return bigVal, err == nil
 }
@@ -0,0 +1,50 @@
+package adapter
+
+import (
+	"encoding/json"
+	"testing"
+
+	personio "github.com/giantswarm/personio-go/v1"
+)
+
+func TestNumberToInt64(t *testing.T) {
+	tests := []struct {
+		value any
+		want  int64
+		ok    bool
+	}{
+		{json.Number("9007199254740993"), 9007199254740993, true},
+		{json.Number("-42"), -42, true},
+		{json.Number("5.0"), 5, true},
+		{json.Number("1e3"), 1000, true},
+		{float64(7), 7, true},
+		{json.Number("5.5"), 0, false},
+		{json.Number("9223372036854775808"), 0, false},
+		{json.Number("-1e30"), 0, false},
+		{"5", 0, false},
+		{nil, 0, false},
+	}
+	for _, tt := range tests {
+		got, ok := numberToInt64(tt.value)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("numberToInt64(%#v) = %d, %t, want %d, %t", tt.value, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestConvertNestedMapItemWithUnexpectedShape(t *testing.T) {
+	attrs := []personio.Attribute{
+		{Type: "standard", Value: map[string]interface{}{}},
+		{Type: "standard", Value: map[string]interface{}{"id": "13649297"}},
+		{Type: "standard", Value: map[string]interface{}{"id": nil}},
+		{Type: "standard", Value: "unexpected"},
+	}
+	for _, a := range attrs {
+		if got := convertNestedMapItemToInt(a, "id"); !got.IsNull() {
+			t.Errorf("expected null for %#v, got %s", a.Value, got)
+		}
+		if got := convertNestedMapItemToString(a, "id"); !got.IsNull() {
+			t.Errorf("expected null for %#v, got %s", a.Value, got)
+		}
+	}
+}
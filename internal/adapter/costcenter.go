@@ -0,0 +1,68 @@
+package adapter
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// CostCenterSummary is a cost center with the employees assigned to it.
+type CostCenterSummary struct {
+	Id          types.Int64            `tfsdk:"id"`
+	Name        types.String           `tfsdk:"name"`
+	EmployeeIds []types.Int64          `tfsdk:"employee_ids"`
+	Headcount   types.Int64            `tfsdk:"headcount"`
+	Allocations []CostCenterAllocation `tfsdk:"allocations"`
+}
+
+// CostCenterAllocation is the share of an employee's costs assigned to a cost center.
+type CostCenterAllocation struct {
+	EmployeeId types.Int64   `tfsdk:"employee_id"`
+	Percentage types.Float64 `tfsdk:"percentage"`
+}
+
+// GetCostCenters returns the cost centers of the employees that pass the filter,
+// ordered by ID. Cost centers without employees are not known to the API.
+func (p *PersonioAdapter) GetCostCenters(ctx context.Context, filter EmployeeFilter) (costCenters []CostCenterSummary, err error) {
+	employees, err := p.GetEmployees(ctx, filter)
+	if err != nil {
+		return costCenters, err
+	}
+	units := groupEmployeesMulti(employees, func(e Employee) (refs []orgUnitRef) {
+		for _, cc := range e.CostCenters {
+			refs = append(refs, orgUnitRef{id: cc.Id, name: cc.Name})
+		}
+		return refs
+	})
+	for _, u := range units {
+		summary := CostCenterSummary{
+			Id:          types.Int64Value(u.id),
+			Name:        u.name,
+			EmployeeIds: u.employeeIds(),
+			Headcount:   types.Int64Value(int64(len(u.employees))),
+			Allocations: make([]CostCenterAllocation, 0, len(u.employees)),
+		}
+		for _, e := range u.employees {
+			summary.Allocations = append(summary.Allocations, CostCenterAllocation{
+				EmployeeId: e.Id,
+				Percentage: costCenterPercentage(e, u.id),
+			})
+		}
+		costCenters = append(costCenters, summary)
+	}
+	return costCenters, nil
+}
+
+// costCenterPercentage returns the percentage of the employee's costs
+// that is assigned to the cost center with the given ID. If the employee
+// lists the cost center several times, their percentages are added up.
+func costCenterPercentage(e Employee, id int64) types.Float64 {
+	res := types.Float64Null()
+	for _, cc := range e.CostCenters {
+		if cc.Id.IsNull() || cc.Id.ValueInt64() != id || cc.Percentage.IsNull() {
+			continue
+		}
+		res = types.Float64Value(res.ValueFloat64() + cc.Percentage.ValueFloat64())
+	}
+	return res
+}
@@ -0,0 +1,36 @@
+package adapter
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestCostCentersCountEmployeesOnce(t *testing.T) {
+	e := Employee{EmployeeRecord: EmployeeRecord{
+		Id: types.Int64Value(1),
+		CostCenters: []CostCenter{
+			{Id: types.Int64Value(10), Name: types.StringValue("A"), Percentage: types.Float64Value(30)},
+			{Id: types.Int64Value(20), Name: types.StringValue("B"), Percentage: types.Float64Value(40)},
+			{Id: types.Int64Value(10), Name: types.StringValue("A"), Percentage: types.Float64Value(30)},
+		},
+	}}
+	units := groupEmployeesMulti([]Employee{e}, func(e Employee) (refs []orgUnitRef) {
+		for _, cc := range e.CostCenters {
+			refs = append(refs, orgUnitRef{id: cc.Id, name: cc.Name})
+		}
+		return refs
+	})
+	if len(units) != 2 {
+		t.Fatalf("expected 2 cost centers, got %d", len(units))
+	}
+	if got := len(units[0].employees); got != 1 {
+		t.Errorf("expected the employee to be counted once, got %d", got)
+	}
+	if got := costCenterPercentage(e, 10).ValueFloat64(); got != 60 {
+		t.Errorf("expected the percentages to be added up to 60, got %v", got)
+	}
+	if got := costCenterPercentage(e, 30); !got.IsNull() {
+		t.Errorf("expected no percentage for an unknown cost center, got %v", got)
+	}
+}
@@ -0,0 +1,35 @@
+package adapter
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type Department struct {
+	Id          types.Int64   `tfsdk:"id"`
+	Name        types.String  `tfsdk:"name"`
+	EmployeeIds []types.Int64 `tfsdk:"employee_ids"`
+	Headcount   types.Int64   `tfsdk:"headcount"`
+}
+
+// GetDepartments returns the departments of the employees that pass the filter,
+// ordered by ID. Departments without employees are not known to the API.
+func (p *PersonioAdapter) GetDepartments(ctx context.Context, filter EmployeeFilter) (departments []Department, err error) {
+	employees, err := p.GetEmployees(ctx, filter)
+	if err != nil {
+		return departments, err
+	}
+	units := groupEmployees(employees, func(e Employee) (types.Int64, types.String) {
+		return e.Profile.DepartmentId, e.Profile.Department
+	})
+	for _, u := range units {
+		departments = append(departments, Department{
+			Id:          types.Int64Value(u.id),
+			Name:        u.name,
+			EmployeeIds: u.employeeIds(),
+			Headcount:   types.Int64Value(int64(len(u.employees))),
+		})
+	}
+	return departments, nil
+}
@@ -0,0 +1,51 @@
+package adapter
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type DocumentCategory struct {
+	Id   types.Int64  `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+}
+
+// apiDocumentCategory is an item of the document categories endpoint.
+type apiDocumentCategory struct {
+	Attributes struct {
+		Id   int64  `json:"id"`
+		Name string `json:"name"`
+	} `json:"attributes"`
+}
+
+func NewDocumentCategory(c apiDocumentCategory) DocumentCategory {
+	return DocumentCategory{
+		Id:   types.Int64Value(c.Attributes.Id),
+		Name: types.StringValue(c.Attributes.Name),
+	}
+}
+
+// GetDocumentCategories returns the categories that employee documents can be filed under.
+func (p *PersonioAdapter) GetDocumentCategories(ctx context.Context) (categories []DocumentCategory, err error) {
+	apiCategories, err := cached(p.cache, documentCategoriesCacheKey, func() ([]apiDocumentCategory, error) {
+		body, err := p.client.get(ctx, "/company/document-categories", nil)
+		if err != nil {
+			return nil, err
+		}
+		var result struct {
+			Data []apiDocumentCategory `json:"data"`
+		}
+		if err := decodeJson(body, &result); err != nil {
+			return nil, err
+		}
+		return result.Data, nil
+	})
+	if err != nil {
+		return categories, err
+	}
+	for _, c := range apiCategories {
+		categories = append(categories, NewDocumentCategory(c))
+	}
+	return categories, nil
+}
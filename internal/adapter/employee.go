@@ -5,10 +5,15 @@ import (
 
 	personio "github.com/giantswarm/personio-go/v1"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/nicoangelo/terraform-provider-personio/internal/formatter"
+	"github.com/nicoangelo/terraform-provider-personio/internal/utils"
 )
 
-type Employee struct {
-	Id        types.Number `tfsdk:"id"`
+// EmployeeRecord holds the attributes of an employee. It is shared by
+// Employee and the supervisors in its supervisor chain, which Terraform
+// schemas cannot nest recursively.
+type EmployeeRecord struct {
+	Id        types.Int64  `tfsdk:"id"`
 	Email     types.String `tfsdk:"email"`
 	FirstName types.String `tfsdk:"first_name"`
 	LastName  types.String `tfsdk:"last_name"`
@@ -16,12 +21,52 @@ type Employee struct {
 
 	CreatedAt      types.String `tfsdk:"created_at"`
 	LastModifiedAt types.String `tfsdk:"last_modified_at"`
+	ContentHash    types.String `tfsdk:"content_hash"`
+
+	DateOfBirth    types.String `tfsdk:"date_of_birth"`
+	ProfilePicture types.String `tfsdk:"profile_picture"`
+
+	Profile            *EmployeeProfile          `tfsdk:"profile"`
+	HrInfo             *EmployeeHrData           `tfsdk:"hr_info"`
+	SalaryData         *EmployeeSalaryData       `tfsdk:"salary_data"`
+	WorkSchedule       *WorkSchedule             `tfsdk:"work_schedule"`
+	HolidayCalendar    *HolidayCalendar          `tfsdk:"holiday_calendar"`
+	AbsenceEntitlement []AbsenceEntitlement      `tfsdk:"absence_entitlement"`
+	CostCenters        []CostCenter              `tfsdk:"cost_centers"`
+	DynamicAttributes  map[string]types.String   `tfsdk:"dynamic_attributes"`
+	TagAttributes      map[string][]types.String `tfsdk:"tag_attributes"`
+
+	DynamicAttributesTyped   map[string]TypedAttribute `tfsdk:"dynamic_attributes_typed"`
+	DynamicAttributesByLabel map[string]types.String   `tfsdk:"dynamic_attributes_by_label"`
+	AttributeLabels          map[string]types.String   `tfsdk:"attribute_labels"`
+}
+
+type Employee struct {
+	EmployeeRecord
 
-	Profile           *EmployeeProfile          `tfsdk:"profile"`
-	HrInfo            *EmployeeHrData           `tfsdk:"hr_info"`
-	SalaryData        *EmployeeSalaryData       `tfsdk:"salary_data"`
-	DynamicAttributes map[string]types.String   `tfsdk:"dynamic_attributes"`
-	TagAttributes     map[string][]types.String `tfsdk:"tag_attributes"`
+	SupervisorChain []EmployeeRecord `tfsdk:"supervisor_chain"`
+}
+
+// TypedAttribute holds a dynamic attribute in the representation that matches
+// its Personio type. Only the value fields that apply to the type are set.
+//
+// A nested object with one field per kind of value is used instead of
+// types.Dynamic: the attribute keeps a fixed schema that is documented and
+// checked by Terraform, and configurations can address the value without
+// type conversions.
+type TypedAttribute struct {
+	Type        types.String   `tfsdk:"type"`
+	StringValue types.String   `tfsdk:"string_value"`
+	NumberValue types.Number   `tfsdk:"number_value"`
+	DateValue   *DateValue     `tfsdk:"date_value"`
+	SetValue    []types.String `tfsdk:"set_value"`
+	Options     []types.String `tfsdk:"options"`
+}
+
+type DateValue struct {
+	Year  types.Int64 `tfsdk:"year"`
+	Month types.Int64 `tfsdk:"month"`
+	Day   types.Int64 `tfsdk:"day"`
 }
 
 type EmployeeProfile struct {
@@ -31,6 +76,7 @@ type EmployeeProfile struct {
 	Team         types.String `tfsdk:"team"`
 	TeamId       types.Int64  `tfsdk:"team_id"`
 	Office       types.String `tfsdk:"office"`
+	OfficeId     types.Int64  `tfsdk:"office_id"`
 	Subcompany   types.String `tfsdk:"subcompany"`
 	Supervisor   *Supervisor  `tfsdk:"supervisor"`
 }
@@ -55,29 +101,88 @@ type EmployeeSalaryData struct {
 	HourlySalary      types.Float64 `tfsdk:"hourly_salary"`
 }
 
+// WorkSchedule is the weekly work schedule of an employee, with the
+// working hours per weekday in HH:MM format.
+type WorkSchedule struct {
+	Id        types.Int64  `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+	ValidFrom types.String `tfsdk:"valid_from"`
+	Monday    types.String `tfsdk:"monday"`
+	Tuesday   types.String `tfsdk:"tuesday"`
+	Wednesday types.String `tfsdk:"wednesday"`
+	Thursday  types.String `tfsdk:"thursday"`
+	Friday    types.String `tfsdk:"friday"`
+	Saturday  types.String `tfsdk:"saturday"`
+	Sunday    types.String `tfsdk:"sunday"`
+}
+
+type HolidayCalendar struct {
+	Id      types.Int64  `tfsdk:"id"`
+	Name    types.String `tfsdk:"name"`
+	Country types.String `tfsdk:"country"`
+	State   types.String `tfsdk:"state"`
+}
+
+// AbsenceEntitlement is the entitlement of an employee for one time-off type.
+type AbsenceEntitlement struct {
+	Id          types.Int64   `tfsdk:"id"`
+	Name        types.String  `tfsdk:"name"`
+	Category    types.String  `tfsdk:"category"`
+	Entitlement types.Float64 `tfsdk:"entitlement"`
+}
+
+type CostCenter struct {
+	Id         types.Int64   `tfsdk:"id"`
+	Name       types.String  `tfsdk:"name"`
+	Percentage types.Float64 `tfsdk:"percentage"`
+}
+
 type Supervisor struct {
-	Id        types.Number `tfsdk:"id"`
+	Id        types.Int64  `tfsdk:"id"`
 	Email     types.String `tfsdk:"email"`
 	FirstName types.String `tfsdk:"first_name"`
 	LastName  types.String `tfsdk:"last_name"`
 }
 
+// PresetAttributeKeys are the keys of the preset employee attributes, which
+// are returned in the fields of EmployeeRecord rather than as dynamic attributes.
+var PresetAttributeKeys = []string{
+	"id", "email", "first_name", "last_name", "status", "created_at", "last_modified_at",
+	"date_of_birth", "profile_picture", "gender", "position", "supervisor", "employment_type",
+	"weekly_working_hours", "hire_date", "contract_end_date", "termination_date", "termination_type",
+	"termination_reason", "probation_period_end", "last_working_day", "subcompany", "office",
+	"department", "team", "cost_centers", "holiday_calendar", "absence_entitlement", "work_schedule",
+	"fix_salary", "fix_salary_interval", "hourly_salary", "vacation_day_balance",
+}
+
 func NewEmployee(pe *personio.Employee) (e Employee) {
-	e.Id = convertAttrToNumber(pe.Attributes["id"])
+	e.Id = convertAttrToInt(pe.Attributes["id"])
 	e.Email = convertAttrToString(pe.Attributes["email"])
 	e.FirstName = convertAttrToString(pe.Attributes["first_name"])
 	e.LastName = convertAttrToString(pe.Attributes["last_name"])
 	e.Status = convertAttrToString(pe.Attributes["status"])
 	e.CreatedAt = convertAttrToDateString(pe.Attributes["created_at"])
 	e.LastModifiedAt = convertAttrToDateString(pe.Attributes["last_modified_at"])
+	e.ContentHash = types.StringValue(utils.Hash(pe.Attributes))
+	e.DateOfBirth = convertAttrToDateString(pe.Attributes["date_of_birth"])
+	e.ProfilePicture = convertAttrToString(pe.Attributes["profile_picture"])
 
 	e.HrInfo = convertHrData(pe.Attributes)
 	e.SalaryData = convertSalaryData(pe.Attributes)
 	e.Profile = convertProfile(pe.Attributes)
+	e.WorkSchedule = convertWorkSchedule(pe.Attributes["work_schedule"])
+	e.HolidayCalendar = convertHolidayCalendar(pe.Attributes["holiday_calendar"])
+	e.AbsenceEntitlement = convertAbsenceEntitlement(pe.Attributes["absence_entitlement"])
+	e.CostCenters = convertCostCenters(pe.Attributes["cost_centers"])
 	e.DynamicAttributes = map[string]types.String{}
 	e.TagAttributes = map[string][]types.String{}
+	e.DynamicAttributesTyped = map[string]TypedAttribute{}
+	e.AttributeLabels = map[string]types.String{}
 
 	for k, v := range pe.Attributes {
+		if v.Label != "" {
+			e.AttributeLabels[k] = types.StringValue(v.Label)
+		}
 		if !strings.HasPrefix(k, "dynamic_") {
 			continue
 		}
@@ -86,11 +191,59 @@ func NewEmployee(pe *personio.Employee) (e Employee) {
 		} else {
 			e.DynamicAttributes[k] = convertAnyAttrToString(v)
 		}
-
+		e.DynamicAttributesTyped[k] = convertAnyAttrToTyped(v)
 	}
+	e.DynamicAttributesByLabel = dynamicAttributesByLabel(e.DynamicAttributes, e.AttributeLabels)
 	return e
 }
 
+// ApplyFormats runs the configured formatters on the string
+// representations of the dynamic attributes.
+func (e *Employee) ApplyFormats(fc *formatter.FormatterCollection) {
+	labels := make(map[string]string, len(e.AttributeLabels))
+	for k, v := range e.AttributeLabels {
+		labels[k] = v.ValueString()
+	}
+	fc.FormatAll(e.DynamicAttributes, labels)
+	for k, v := range e.DynamicAttributesTyped {
+		v.StringValue = fc.Format(k, labels[k], v.StringValue)
+		e.DynamicAttributesTyped[k] = v
+	}
+	e.DynamicAttributesByLabel = dynamicAttributesByLabel(e.DynamicAttributes, e.AttributeLabels)
+}
+
+// DynamicAttribute returns the string representation of the dynamic attribute
+// with the given key or label. Preset and tag attributes are not found.
+func (e EmployeeRecord) DynamicAttribute(keyOrLabel string) (types.String, bool) {
+	if v, ok := e.DynamicAttributes[keyOrLabel]; ok {
+		return v, true
+	}
+	v, ok := e.DynamicAttributesByLabel[keyOrLabel]
+	return v, ok
+}
+
+// dynamicAttributesByLabel re-keys the dynamic attributes by their label.
+// Labels that are used by more than one attribute are left out, as they
+// cannot be resolved to a single value.
+func dynamicAttributesByLabel(attrs map[string]types.String, labels map[string]types.String) map[string]types.String {
+	res := map[string]types.String{}
+	ambiguous := map[string]bool{}
+	for k, v := range attrs {
+		label, ok := labels[k]
+		if !ok {
+			continue
+		}
+		if _, exists := res[label.ValueString()]; exists {
+			ambiguous[label.ValueString()] = true
+		}
+		res[label.ValueString()] = v
+	}
+	for label := range ambiguous {
+		delete(res, label)
+	}
+	return res
+}
+
 func convertSalaryData(attrs map[string]personio.Attribute) *EmployeeSalaryData {
 	return &EmployeeSalaryData{
 		FixSalary:         convertAttrToFloat(attrs["fix_salary"]),
@@ -118,6 +271,7 @@ func convertProfile(attrs map[string]personio.Attribute) *EmployeeProfile {
 	return &EmployeeProfile{
 		Gender:       convertAttrToString(attrs["gender"]),
 		Office:       convertMapItemToString(attrs["office"], "name"),
+		OfficeId:     convertMapItemToInt(attrs["office"], "id"),
 		Subcompany:   convertAttrToString(attrs["subcompany"]),
 		Department:   convertMapItemToString(attrs["department"], "name"),
 		DepartmentId: convertMapItemToInt(attrs["department"], "id"),
@@ -129,9 +283,72 @@ func convertProfile(attrs map[string]personio.Attribute) *EmployeeProfile {
 
 func convertSupervisor(v personio.Attribute) *Supervisor {
 	return &Supervisor{
-		Id:        convertNestedMapItemToNumber(v, "id"),
+		Id:        convertNestedMapItemToInt(v, "id"),
 		Email:     convertNestedMapItemToString(v, "email"),
 		FirstName: convertNestedMapItemToString(v, "first_name"),
 		LastName:  convertNestedMapItemToString(v, "last_name"),
 	}
 }
+
+func convertWorkSchedule(v personio.Attribute) *WorkSchedule {
+	if v.Value == nil {
+		return nil
+	}
+	return &WorkSchedule{
+		Id:        convertMapItemToInt(v, "id"),
+		Name:      convertMapItemToString(v, "name"),
+		ValidFrom: convertMapItemToString(v, "valid_from"),
+		Monday:    convertMapItemToString(v, "monday"),
+		Tuesday:   convertMapItemToString(v, "tuesday"),
+		Wednesday: convertMapItemToString(v, "wednesday"),
+		Thursday:  convertMapItemToString(v, "thursday"),
+		Friday:    convertMapItemToString(v, "friday"),
+		Saturday:  convertMapItemToString(v, "saturday"),
+		Sunday:    convertMapItemToString(v, "sunday"),
+	}
+}
+
+func convertHolidayCalendar(v personio.Attribute) *HolidayCalendar {
+	if v.Value == nil {
+		return nil
+	}
+	return &HolidayCalendar{
+		Id:      convertMapItemToInt(v, "id"),
+		Name:    convertMapItemToString(v, "name"),
+		Country: convertMapItemToString(v, "country"),
+		State:   convertMapItemToString(v, "state"),
+	}
+}
+
+func convertAbsenceEntitlement(v personio.Attribute) (res []AbsenceEntitlement) {
+	items := convertListToAttributes(v)
+	if items == nil {
+		return nil
+	}
+	res = make([]AbsenceEntitlement, 0, len(items))
+	for _, item := range items {
+		res = append(res, AbsenceEntitlement{
+			Id:          convertMapItemToInt(item, "id"),
+			Name:        convertMapItemToString(item, "name"),
+			Category:    convertMapItemToString(item, "category"),
+			Entitlement: convertMapItemToFloat(item, "entitlement"),
+		})
+	}
+	return res
+}
+
+func convertCostCenters(v personio.Attribute) (res []CostCenter) {
+	items := convertListToAttributes(v)
+	if items == nil {
+		return nil
+	}
+	res = make([]CostCenter, 0, len(items))
+	for _, item := range items {
+		res = append(res, CostCenter{
+			Id:         convertMapItemToInt(item, "id"),
+			Name:       convertMapItemToString(item, "name"),
+			Percentage: convertMapItemToFloat(item, "percentage"),
+		})
+	}
+	return res
+}
@@ -0,0 +1,68 @@
+package adapter
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/jesse0michael/go-rest-assured/assured"
+)
+
+func TestLargeIdsAreDecodedExactly(t *testing.T) {
+	emps, _ := os.ReadFile("../../test/data/large_id_employees.json")
+	c := restServerWith(assured.Call{
+		Path:       "/company/employees",
+		Method:     "GET",
+		StatusCode: 200,
+		Response:   emps,
+	})
+	defer c.Close()
+
+	p := testAdapter(t, c, DefaultAdapterOptions())
+	employees, err := p.GetEmployees(context.Background(), EmployeeFilter{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(employees) != 2 {
+		t.Fatalf("expected 2 employees, got %d", len(employees))
+	}
+
+	e := employees[1]
+	checks := map[string][2]string{
+		"id":                      {e.Id.String(), "9007199254740995"},
+		"supervisor id":           {e.Profile.Supervisor.Id.String(), "9007199254740993"},
+		"department id":           {e.Profile.DepartmentId.String(), "9007199254740997"},
+		"work schedule id":        {e.WorkSchedule.Id.String(), "1067807102794692001"},
+		"dynamic attribute":       {e.DynamicAttributes["dynamic_7124060"].ValueString(), "9007199254740999"},
+		"typed dynamic attribute": {e.DynamicAttributesTyped["dynamic_7124060"].NumberValue.ValueBigFloat().Text('f', -1), "9007199254740999"},
+	}
+	for name, c := range checks {
+		if c[0] != c[1] {
+			t.Errorf("expected %s %s, got %s", name, c[1], c[0])
+		}
+	}
+}
+
+func TestEmployeeWithLargeIdIsFoundInCachedList(t *testing.T) {
+	emps, _ := os.ReadFile("../../test/data/large_id_employees.json")
+	c := restServerWith(assured.Call{
+		Path:       "/company/employees",
+		Method:     "GET",
+		StatusCode: 200,
+		Response:   emps,
+	})
+	defer c.Close()
+
+	p := testAdapter(t, c, DefaultAdapterOptions())
+	if _, err := p.GetEmployees(context.Background(), EmployeeFilter{}); err != nil {
+		t.Fatal(err)
+	}
+	// 9007199254740993 and 9007199254740992 are the same float64
+	e, err := p.GetEmployee(context.Background(), 9007199254740993)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := e.Email.ValueString(); got != "ada.lovelace@example.com" {
+		t.Errorf("expected ada.lovelace@example.com, got %s", got)
+	}
+}
@@ -0,0 +1,140 @@
+package adapter
+
+import (
+	"cmp"
+	"net/url"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	updatedSinceQueryFormat = "2006-01-02T15:04:05"
+)
+
+// EmployeeSortFields are the employee attributes that the list of employees can be sorted by.
+var EmployeeSortFields = []string{"id", "email", "first_name", "last_name", "created_at", "last_modified_at"}
+
+// EmployeeFilter narrows down, sorts and limits the list of employees.
+// Filters that the Personio API supports are sent as query parameters,
+// all others are applied after the employees have been fetched.
+// Zero values do not filter.
+type EmployeeFilter struct {
+	Email        string
+	Statuses     []string
+	DepartmentId *int64
+	TeamId       *int64
+	Office       string
+	UpdatedSince *time.Time
+	// DynamicAttributes maps dynamic attribute keys or labels to the expected value.
+	DynamicAttributes map[string]string
+
+	// SortBy is one of EmployeeSortFields. Empty keeps the order of the API.
+	SortBy string
+	// Limit is the maximum number of employees returned. Zero means unlimited.
+	Limit int
+}
+
+// query returns the filters that are supported by the Personio API as query parameters.
+func (f EmployeeFilter) query() url.Values {
+	q := url.Values{}
+	if f.Email != "" {
+		q.Set("email", f.Email)
+	}
+	if f.UpdatedSince != nil {
+		q.Set("updated_since", f.UpdatedSince.UTC().Format(updatedSinceQueryFormat))
+	}
+	return q
+}
+
+// matches reports whether the employee passes all filters. Filters that
+// are sent to the API are checked as well, as the employees may have been
+// taken from the cached list of all employees.
+func (f EmployeeFilter) matches(e Employee) bool {
+	if f.Email != "" && !strings.EqualFold(e.Email.ValueString(), f.Email) {
+		return false
+	}
+	if len(f.Statuses) > 0 && !slices.Contains(f.Statuses, e.Status.ValueString()) {
+		return false
+	}
+	if f.DepartmentId != nil && (e.Profile.DepartmentId.IsNull() || e.Profile.DepartmentId.ValueInt64() != *f.DepartmentId) {
+		return false
+	}
+	if f.TeamId != nil && (e.Profile.TeamId.IsNull() || e.Profile.TeamId.ValueInt64() != *f.TeamId) {
+		return false
+	}
+	if f.Office != "" && e.Profile.Office.ValueString() != f.Office {
+		return false
+	}
+	if f.UpdatedSince != nil {
+		modified, err := time.Parse(time.RFC3339, e.LastModifiedAt.ValueString())
+		if err != nil || modified.Before(*f.UpdatedSince) {
+			return false
+		}
+	}
+	for keyOrLabel, value := range f.DynamicAttributes {
+		attr, ok := e.DynamicAttribute(keyOrLabel)
+		if !ok || attr.IsNull() || attr.ValueString() != value {
+			return false
+		}
+	}
+	return true
+}
+
+// apply filters, sorts and limits the employees.
+func (f EmployeeFilter) apply(employees []Employee) []Employee {
+	res := make([]Employee, 0, len(employees))
+	for _, e := range employees {
+		if f.matches(e) {
+			res = append(res, e)
+		}
+	}
+	if f.SortBy != "" {
+		sort.SliceStable(res, func(i, j int) bool {
+			if c := compareEmployees(res[i], res[j], f.SortBy); c != 0 {
+				return c < 0
+			}
+			// ties are ordered by ID to keep the order stable between runs
+			return compareEmployees(res[i], res[j], "id") < 0
+		})
+	}
+	if f.Limit > 0 && len(res) > f.Limit {
+		res = res[:f.Limit]
+	}
+	return res
+}
+
+// compareEmployees compares two employees by one of the EmployeeSortFields.
+func compareEmployees(a Employee, b Employee, field string) int {
+	switch field {
+	case "id":
+		if a.Id.IsNull() || b.Id.IsNull() {
+			return boolCompare(a.Id.IsNull(), b.Id.IsNull())
+		}
+		return cmp.Compare(a.Id.ValueInt64(), b.Id.ValueInt64())
+	case "email":
+		return strings.Compare(a.Email.ValueString(), b.Email.ValueString())
+	case "first_name":
+		return strings.Compare(a.FirstName.ValueString(), b.FirstName.ValueString())
+	case "last_name":
+		return strings.Compare(a.LastName.ValueString(), b.LastName.ValueString())
+	case "created_at":
+		// RFC3339 timestamps in UTC sort lexically
+		return strings.Compare(a.CreatedAt.ValueString(), b.CreatedAt.ValueString())
+	case "last_modified_at":
+		return strings.Compare(a.LastModifiedAt.ValueString(), b.LastModifiedAt.ValueString())
+	}
+	return 0
+}
+
+// boolCompare orders true before false.
+func boolCompare(a bool, b bool) int {
+	switch {
+	case a == b:
+		return 0
+	case a:
+		return -1
+	}
+	return 1
+}
@@ -0,0 +1,35 @@
+package adapter
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type Office struct {
+	Id          types.Int64   `tfsdk:"id"`
+	Name        types.String  `tfsdk:"name"`
+	EmployeeIds []types.Int64 `tfsdk:"employee_ids"`
+	Headcount   types.Int64   `tfsdk:"headcount"`
+}
+
+// GetOffices returns the offices of the employees that pass the filter,
+// ordered by ID. Offices without employees are not known to the API.
+func (p *PersonioAdapter) GetOffices(ctx context.Context, filter EmployeeFilter) (offices []Office, err error) {
+	employees, err := p.GetEmployees(ctx, filter)
+	if err != nil {
+		return offices, err
+	}
+	units := groupEmployees(employees, func(e Employee) (types.Int64, types.String) {
+		return e.Profile.OfficeId, e.Profile.Office
+	})
+	for _, u := range units {
+		offices = append(offices, Office{
+			Id:          types.Int64Value(u.id),
+			Name:        u.name,
+			EmployeeIds: u.employeeIds(),
+			Headcount:   types.Int64Value(int64(len(u.employees))),
+		})
+	}
+	return offices, nil
+}
@@ -0,0 +1,201 @@
+package adapter
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// OrgChartEntry is the position of an employee in the reporting tree.
+type OrgChartEntry struct {
+	EmployeeId    types.Int64   `tfsdk:"employee_id"`
+	SupervisorId  types.Int64   `tfsdk:"supervisor_id"`
+	ManagerChain  []types.Int64 `tfsdk:"manager_chain"`
+	DirectReports []types.Int64 `tfsdk:"direct_reports"`
+	AllReports    []types.Int64 `tfsdk:"all_reports"`
+	Depth         types.Int64   `tfsdk:"depth"`
+}
+
+// OrgChart is the reporting tree of the employees, derived from their supervisors.
+// Warnings describes the supervisor relations that had to be ignored to build the tree.
+type OrgChart struct {
+	Entries  []OrgChartEntry
+	RootIds  []types.Int64
+	Warnings []string
+}
+
+// GetOrgChart builds the reporting tree of all employees. If rootId is not
+// nil, only the employee with that ID and its reports are returned, and the
+// manager chains end at that employee.
+func (p *PersonioAdapter) GetOrgChart(ctx context.Context, rootId *int64) (chart OrgChart, err error) {
+	employees, err := p.GetEmployees(ctx, EmployeeFilter{})
+	if err != nil {
+		return chart, err
+	}
+	tree := newOrgTree(employees)
+	chart.Warnings = tree.warnings
+
+	roots := tree.roots
+	if rootId != nil {
+		if _, ok := tree.children[*rootId]; !ok {
+			return chart, fmt.Errorf("employee %d not found", *rootId)
+		}
+		roots = []int64{*rootId}
+	}
+	for _, id := range roots {
+		chart.RootIds = append(chart.RootIds, types.Int64Value(id))
+		chart.Entries = append(chart.Entries, tree.entries(id, nil)...)
+	}
+	sort.Slice(chart.Entries, func(i, j int) bool {
+		return chart.Entries[i].EmployeeId.ValueInt64() < chart.Entries[j].EmployeeId.ValueInt64()
+	})
+	return chart, nil
+}
+
+// orgTree is the reporting tree of employees. Supervisor relations that point
+// to unknown employees or form cycles are dropped, so that every employee is
+// reachable from exactly one root.
+type orgTree struct {
+	supervisor map[int64]int64
+	children   map[int64][]int64
+	roots      []int64
+	warnings   []string
+}
+
+func newOrgTree(employees []Employee) *orgTree {
+	t := &orgTree{
+		supervisor: map[int64]int64{},
+		children:   map[int64][]int64{},
+	}
+	ids := make([]int64, 0, len(employees))
+	for _, e := range employees {
+		if e.Id.IsNull() {
+			continue
+		}
+		ids = append(ids, e.Id.ValueInt64())
+		t.children[e.Id.ValueInt64()] = nil
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	for _, e := range employees {
+		if e.Id.IsNull() || e.Profile == nil {
+			continue
+		}
+		s := e.Profile.Supervisor
+		if s == nil || s.Id.IsNull() {
+			continue
+		}
+		id, supervisorId := e.Id.ValueInt64(), s.Id.ValueInt64()
+		if _, ok := t.children[supervisorId]; !ok {
+			t.warnings = append(t.warnings, fmt.Sprintf(
+				"The supervisor %d of employee %d is not among the employees. Employee %d is treated as a root of the org chart.",
+				supervisorId, id, id))
+			continue
+		}
+		t.supervisor[id] = supervisorId
+	}
+	t.breakCycles(ids)
+
+	for _, id := range ids {
+		if supervisorId, ok := t.supervisor[id]; ok {
+			t.children[supervisorId] = append(t.children[supervisorId], id)
+		} else {
+			t.roots = append(t.roots, id)
+		}
+	}
+	return t
+}
+
+// breakCycles drops the supervisor relation of the employee with the lowest
+// ID in every cycle of supervisor relations, making that employee a root.
+func (t *orgTree) breakCycles(ids []int64) {
+	const (
+		unvisited = iota
+		onPath
+		done
+	)
+	state := map[int64]int{}
+	for _, id := range ids {
+		var path []int64
+		cycleStart := -1
+		for cur := id; ; {
+			if state[cur] == onPath {
+				for i, p := range path {
+					if p == cur {
+						cycleStart = i
+					}
+				}
+				break
+			}
+			if state[cur] == done {
+				break
+			}
+			state[cur] = onPath
+			path = append(path, cur)
+			next, ok := t.supervisor[cur]
+			if !ok {
+				break
+			}
+			cur = next
+		}
+		if cycleStart >= 0 {
+			cycle := append([]int64(nil), path[cycleStart:]...)
+			sort.Slice(cycle, func(i, j int) bool { return cycle[i] < cycle[j] })
+			members := make([]string, 0, len(cycle))
+			for _, m := range cycle {
+				members = append(members, fmt.Sprint(m))
+			}
+			if len(cycle) == 1 {
+				t.warnings = append(t.warnings, fmt.Sprintf(
+					"Employee %d is their own supervisor. Employee %d is treated as a root of the org chart.",
+					cycle[0], cycle[0]))
+			} else {
+				t.warnings = append(t.warnings, fmt.Sprintf(
+					"The supervisors of employees %s form a cycle. Employee %d is treated as a root of the org chart.",
+					strings.Join(members, ", "), cycle[0]))
+			}
+			delete(t.supervisor, cycle[0])
+		}
+		for _, p := range path {
+			state[p] = done
+		}
+	}
+}
+
+// entries returns the entries of the employee with the given ID and of all its
+// reports. managerChain is the chain of the employee, ordered from its
+// supervisor upwards.
+func (t *orgTree) entries(id int64, managerChain []types.Int64) []OrgChartEntry {
+	entry := OrgChartEntry{
+		EmployeeId:    types.Int64Value(id),
+		SupervisorId:  types.Int64Null(),
+		ManagerChain:  managerChain,
+		DirectReports: []types.Int64{},
+		AllReports:    []types.Int64{},
+		Depth:         types.Int64Value(int64(len(managerChain))),
+	}
+	if len(managerChain) > 0 {
+		entry.SupervisorId = managerChain[0]
+	}
+	if entry.ManagerChain == nil {
+		entry.ManagerChain = []types.Int64{}
+	}
+
+	reportChain := append([]types.Int64{types.Int64Value(id)}, managerChain...)
+	res := []OrgChartEntry{entry}
+	for _, child := range t.children[id] {
+		entry.DirectReports = append(entry.DirectReports, types.Int64Value(child))
+		res = append(res, t.entries(child, reportChain)...)
+	}
+	for _, r := range res[1:] {
+		entry.AllReports = append(entry.AllReports, r.EmployeeId)
+	}
+	sort.Slice(entry.AllReports, func(i, j int) bool {
+		return entry.AllReports[i].ValueInt64() < entry.AllReports[j].ValueInt64()
+	})
+	res[0] = entry
+	return res
+}
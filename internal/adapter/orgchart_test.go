@@ -0,0 +1,105 @@
+package adapter
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// orgChartEmployee returns an employee with the given supervisor. A
+// supervisorId of 0 means that the employee has no supervisor.
+func orgChartEmployee(id int64, supervisorId int64) Employee {
+	e := Employee{EmployeeRecord: EmployeeRecord{Id: types.Int64Value(id), Profile: &EmployeeProfile{}}}
+	if supervisorId != 0 {
+		e.Profile.Supervisor = &Supervisor{Id: types.Int64Value(supervisorId)}
+	}
+	return e
+}
+
+func int64s(values []types.Int64) []int64 {
+	res := make([]int64, 0, len(values))
+	for _, v := range values {
+		res = append(res, v.ValueInt64())
+	}
+	return res
+}
+
+func TestOrgTreeEntries(t *testing.T) {
+	tree := newOrgTree([]Employee{
+		orgChartEmployee(4, 2),
+		orgChartEmployee(1, 0),
+		orgChartEmployee(3, 1),
+		orgChartEmployee(2, 1),
+		orgChartEmployee(5, 4),
+	})
+	if len(tree.warnings) != 0 {
+		t.Errorf("expected no warnings, got %v", tree.warnings)
+	}
+	if !reflect.DeepEqual(tree.roots, []int64{1}) {
+		t.Fatalf("expected root 1, got %v", tree.roots)
+	}
+
+	entries := tree.entries(1, nil)
+	byId := map[int64]OrgChartEntry{}
+	for _, e := range entries {
+		byId[e.EmployeeId.ValueInt64()] = e
+	}
+	if got := int64s(byId[1].AllReports); !reflect.DeepEqual(got, []int64{2, 3, 4, 5}) {
+		t.Errorf("expected all reports 2, 3, 4, 5 of the root, got %v", got)
+	}
+	if got := int64s(byId[1].DirectReports); !reflect.DeepEqual(got, []int64{2, 3}) {
+		t.Errorf("expected direct reports 2, 3 of the root, got %v", got)
+	}
+	if got := int64s(byId[5].ManagerChain); !reflect.DeepEqual(got, []int64{4, 2, 1}) {
+		t.Errorf("expected manager chain 4, 2, 1, got %v", got)
+	}
+	if got := byId[5].Depth.ValueInt64(); got != 3 {
+		t.Errorf("expected depth 3, got %d", got)
+	}
+	if !byId[1].SupervisorId.IsNull() || byId[1].Depth.ValueInt64() != 0 {
+		t.Errorf("expected the root to have no supervisor and depth 0, got %v", byId[1])
+	}
+
+	// a subtree ends the manager chains at its root
+	sub := tree.entries(2, nil)
+	if got := int64s(sub[len(sub)-1].ManagerChain); !reflect.DeepEqual(got, []int64{4, 2}) {
+		t.Errorf("expected manager chain 4, 2 in the subtree, got %v", got)
+	}
+}
+
+func TestOrgTreeBreaksCyclesAndDanglingSupervisors(t *testing.T) {
+	tree := newOrgTree([]Employee{
+		orgChartEmployee(1, 0),
+		orgChartEmployee(2, 99),
+		orgChartEmployee(3, 5),
+		orgChartEmployee(4, 3),
+		orgChartEmployee(5, 4),
+		orgChartEmployee(6, 3),
+		orgChartEmployee(7, 7),
+	})
+	if !reflect.DeepEqual(tree.roots, []int64{1, 2, 3, 7}) {
+		t.Errorf("expected roots 1, 2, 3, 7, got %v", tree.roots)
+	}
+	if len(tree.warnings) != 3 {
+		t.Fatalf("expected 3 warnings, got %v", tree.warnings)
+	}
+	for i, want := range []string{
+		"The supervisor 99 of employee 2 is not among the employees",
+		"The supervisors of employees 3, 4, 5 form a cycle. Employee 3 is treated as a root",
+		"Employee 7 is their own supervisor",
+	} {
+		if !strings.Contains(tree.warnings[i], want) {
+			t.Errorf("expected warning %d to contain %q, got %q", i, want, tree.warnings[i])
+		}
+	}
+
+	entries := tree.entries(3, nil)
+	if len(entries) != 4 {
+		t.Fatalf("expected 3 and its 3 reports, got %d entries", len(entries))
+	}
+	if got := int64s(entries[0].AllReports); !reflect.DeepEqual(got, []int64{4, 5, 6}) {
+		t.Errorf("expected all reports 4, 5, 6, got %v", got)
+	}
+}
@@ -0,0 +1,74 @@
+package adapter
+
+import (
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// orgUnit is an organisational unit (e.g. a department) that is derived from
+// the profiles of its employees, as the Personio API v1 has no endpoints for them.
+type orgUnit struct {
+	id        int64
+	name      types.String
+	employees []Employee
+}
+
+// employeeIds returns the IDs of the employees of the unit.
+func (u *orgUnit) employeeIds() []types.Int64 {
+	ids := make([]types.Int64, 0, len(u.employees))
+	for _, e := range u.employees {
+		ids = append(ids, e.Id)
+	}
+	return ids
+}
+
+// orgUnitRef references the organisational unit an employee belongs to.
+type orgUnitRef struct {
+	id   types.Int64
+	name types.String
+}
+
+// groupEmployees groups the employees by the organisational unit returned
+// by unitOf. Employees without a unit are left out. The units and their
+// employees are ordered by ID.
+func groupEmployees(employees []Employee, unitOf func(e Employee) (id types.Int64, name types.String)) []*orgUnit {
+	return groupEmployeesMulti(employees, func(e Employee) []orgUnitRef {
+		id, name := unitOf(e)
+		return []orgUnitRef{{id: id, name: name}}
+	})
+}
+
+// groupEmployeesMulti groups the employees by the organisational units returned
+// by unitsOf, for units that an employee can belong to several of (e.g. cost centers).
+// An employee that references the same unit several times is added to it once.
+func groupEmployeesMulti(employees []Employee, unitsOf func(e Employee) []orgUnitRef) []*orgUnit {
+	byId := map[int64]*orgUnit{}
+	for _, e := range employees {
+		added := map[int64]bool{}
+		for _, ref := range unitsOf(e) {
+			if ref.id.IsNull() || ref.id.IsUnknown() || added[ref.id.ValueInt64()] {
+				continue
+			}
+			added[ref.id.ValueInt64()] = true
+			u, ok := byId[ref.id.ValueInt64()]
+			if !ok {
+				u = &orgUnit{id: ref.id.ValueInt64(), name: ref.name}
+				byId[ref.id.ValueInt64()] = u
+			}
+			u.employees = append(u.employees, e)
+		}
+	}
+
+	units := make([]*orgUnit, 0, len(byId))
+	for _, u := range byId {
+		sort.SliceStable(u.employees, func(i, j int) bool {
+			return compareEmployees(u.employees[i], u.employees[j], "id") < 0
+		})
+		units = append(units, u)
+	}
+	sort.Slice(units, func(i, j int) bool {
+		return units[i].id < units[j].id
+	})
+	return units
+}
@@ -0,0 +1,86 @@
+package adapter
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"mime"
+	"net/http"
+
+	personio "github.com/giantswarm/personio-go/v1"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ProfilePicture is the profile picture of an employee. All values are
+// null if the employee has no picture.
+type ProfilePicture struct {
+	Exists        types.Bool   `tfsdk:"exists"`
+	ContentBase64 types.String `tfsdk:"content_base64"`
+	ContentType   types.String `tfsdk:"content_type"`
+	Sha256        types.String `tfsdk:"sha256"`
+}
+
+// apiProfilePicture is the response of the profile picture endpoint.
+// Content is nil if the employee has no picture.
+type apiProfilePicture struct {
+	Content     []byte
+	ContentType string
+}
+
+func NewProfilePicture(p apiProfilePicture) ProfilePicture {
+	if len(p.Content) == 0 {
+		return ProfilePicture{
+			Exists:        types.BoolValue(false),
+			ContentBase64: types.StringNull(),
+			ContentType:   types.StringNull(),
+			Sha256:        types.StringNull(),
+		}
+	}
+
+	contentType := p.ContentType
+	if mediaType, _, err := mime.ParseMediaType(contentType); err == nil && mediaType != "application/octet-stream" {
+		contentType = mediaType
+	} else {
+		contentType = http.DetectContentType(p.Content)
+	}
+	sum := sha256.Sum256(p.Content)
+	return ProfilePicture{
+		Exists:        types.BoolValue(true),
+		ContentBase64: types.StringValue(base64.StdEncoding.EncodeToString(p.Content)),
+		ContentType:   types.StringValue(contentType),
+		Sha256:        types.StringValue(hex.EncodeToString(sum[:])),
+	}
+}
+
+// GetProfilePicture returns the profile picture of an employee, scaled to the
+// given width in pixels. If width is nil, the picture is returned in the size
+// chosen by Personio. An employee without a picture is not an error, an
+// unknown employee is.
+func (p *PersonioAdapter) GetProfilePicture(ctx context.Context, employeeId int64, width *int64) (picture ProfilePicture, err error) {
+	path := fmt.Sprintf("/company/employees/%d/profile-picture", employeeId)
+	if width != nil {
+		path += fmt.Sprintf("/%d", *width)
+	}
+	apiPicture, err := cached(p.cache, path, func() (apiProfilePicture, error) {
+		body, contentType, err := p.client.getBinary(ctx, path, "image/*")
+		var statusErr personio.StatusError
+		if errors.As(err, &statusErr) && statusErr.Code == http.StatusNotFound {
+			// the API answers 404 for unknown employees as well
+			if _, err := p.GetEmployee(ctx, employeeId); err != nil {
+				return apiProfilePicture{}, fmt.Errorf("employee %d: %w", employeeId, err)
+			}
+			return apiProfilePicture{}, nil
+		}
+		if err != nil {
+			return apiProfilePicture{}, err
+		}
+		return apiProfilePicture{Content: body, ContentType: contentType}, nil
+	})
+	if err != nil {
+		return picture, err
+	}
+	return NewProfilePicture(apiPicture), nil
+}
@@ -0,0 +1,126 @@
+package adapter
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/jesse0michael/go-rest-assured/assured"
+)
+
+func TestGetProfilePicture(t *testing.T) {
+	png, _ := os.ReadFile("../../test/data/profile_picture.png")
+	c := restServerWith(assured.Call{
+		Path:       "/company/employees/123/profile-picture/64",
+		Method:     "GET",
+		StatusCode: 200,
+		Headers:    map[string]string{"Content-Type": "image/png; charset=binary"},
+		Response:   png,
+	})
+	defer c.Close()
+	p := testAdapter(t, c, DefaultAdapterOptions())
+
+	width := int64(64)
+	picture, err := p.GetProfilePicture(context.Background(), 123, &width)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !picture.Exists.ValueBool() {
+		t.Fatal("expected the picture to exist")
+	}
+	if got := picture.ContentType.ValueString(); got != "image/png" {
+		t.Errorf("expected content type image/png, got %s", got)
+	}
+	if got := picture.ContentBase64.ValueString(); got != "iVBORw0KGgoAAAANSUhEUgAAAAEAAAABCAYAAAAfFcSJAAAAC0lEQVR4nGNgAAIAAAUAAXpeqz8AAAAASUVORK5CYII=" {
+		t.Errorf("unexpected content %s", got)
+	}
+	if got := picture.Sha256.ValueString(); got != "43739c566e26fd7cb88f69d3864ea34740372f5ee99acac169e090beffbce5c6" {
+		t.Errorf("unexpected hash %s", got)
+	}
+}
+
+func TestGetProfilePictureDetectsContentType(t *testing.T) {
+	png, _ := os.ReadFile("../../test/data/profile_picture.png")
+	c := restServerWith(assured.Call{
+		Path:       "/company/employees/123/profile-picture",
+		Method:     "GET",
+		StatusCode: 200,
+		Headers:    map[string]string{"Content-Type": "application/octet-stream"},
+		Response:   png,
+	})
+	defer c.Close()
+	p := testAdapter(t, c, DefaultAdapterOptions())
+
+	picture, err := p.GetProfilePicture(context.Background(), 123, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := picture.ContentType.ValueString(); got != "image/png" {
+		t.Errorf("expected content type image/png, got %s", got)
+	}
+}
+
+func TestGetProfilePictureOfEmployeeWithoutPicture(t *testing.T) {
+	employee, _ := os.ReadFile("../../test/data/one_employee.json")
+	c := restServerWith(assured.Call{
+		Path:       "/company/employees/123/profile-picture/64",
+		Method:     "GET",
+		StatusCode: 404,
+	}, assured.Call{
+		Path:       "/company/employees/123",
+		Method:     "GET",
+		StatusCode: 200,
+		Response:   employee,
+	})
+	defer c.Close()
+	p := testAdapter(t, c, DefaultAdapterOptions())
+	ctx := context.Background()
+
+	width := int64(64)
+	for i := 0; i < 2; i++ {
+		picture, err := p.GetProfilePicture(ctx, 123, &width)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if picture.Exists.ValueBool() || !picture.ContentBase64.IsNull() || !picture.Sha256.IsNull() {
+			t.Errorf("expected no picture, got %+v", picture)
+		}
+	}
+	if got := countCalls(t, c, "GET", "company/employees/123/profile-picture/64"); got != 1 {
+		t.Errorf("expected the missing picture to be cached, got %d requests", got)
+	}
+}
+
+func TestGetProfilePictureOfUnknownEmployee(t *testing.T) {
+	c := restServerWith(assured.Call{
+		Path:       "/company/employees/123/profile-picture/64",
+		Method:     "GET",
+		StatusCode: 404,
+	}, assured.Call{
+		Path:       "/company/employees/123",
+		Method:     "GET",
+		StatusCode: 404,
+	})
+	defer c.Close()
+	p := testAdapter(t, c, DefaultAdapterOptions())
+
+	width := int64(64)
+	if _, err := p.GetProfilePicture(context.Background(), 123, &width); err == nil || err.Error() != "employee 123: 404 Not Found" {
+		t.Fatalf("expected an error for the unknown employee, got %v", err)
+	}
+}
+
+func TestGetProfilePictureFailsOnOtherErrors(t *testing.T) {
+	c := restServerWith(assured.Call{
+		Path:       "/company/employees/123/profile-picture/64",
+		Method:     "GET",
+		StatusCode: 403,
+	})
+	defer c.Close()
+	p := testAdapter(t, c, DefaultAdapterOptions())
+
+	width := int64(64)
+	if _, err := p.GetProfilePicture(context.Background(), 123, &width); err == nil || err.Error() != "403 Forbidden" {
+		t.Fatalf("expected 403 error, got %v", err)
+	}
+}
@@ -0,0 +1,60 @@
+package adapter
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type AttendanceProject struct {
+	Id        types.Int64  `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+	Active    types.Bool   `tfsdk:"active"`
+	CreatedAt types.String `tfsdk:"created_at"`
+	UpdatedAt types.String `tfsdk:"updated_at"`
+}
+
+// apiAttendanceProject is an item of the attendance projects endpoint.
+type apiAttendanceProject struct {
+	Id         int64 `json:"id"`
+	Attributes struct {
+		Name      string  `json:"name"`
+		Active    *bool   `json:"active"`
+		CreatedAt *string `json:"created_at"`
+		UpdatedAt *string `json:"updated_at"`
+	} `json:"attributes"`
+}
+
+func NewAttendanceProject(p apiAttendanceProject) AttendanceProject {
+	return AttendanceProject{
+		Id:        types.Int64Value(p.Id),
+		Name:      types.StringValue(p.Attributes.Name),
+		Active:    types.BoolPointerValue(p.Attributes.Active),
+		CreatedAt: convertToTimestamp(p.Attributes.CreatedAt),
+		UpdatedAt: convertToTimestamp(p.Attributes.UpdatedAt),
+	}
+}
+
+// GetAttendanceProjects returns the projects that attendances can be recorded for.
+func (p *PersonioAdapter) GetAttendanceProjects(ctx context.Context) (projects []AttendanceProject, err error) {
+	apiProjects, err := cached(p.cache, attendanceProjectsCacheKey, func() ([]apiAttendanceProject, error) {
+		body, err := p.client.get(ctx, "/company/attendances/projects", nil)
+		if err != nil {
+			return nil, err
+		}
+		var result struct {
+			Data []apiAttendanceProject `json:"data"`
+		}
+		if err := decodeJson(body, &result); err != nil {
+			return nil, err
+		}
+		return result.Data, nil
+	})
+	if err != nil {
+		return projects, err
+	}
+	for _, ap := range apiProjects {
+		projects = append(projects, NewAttendanceProject(ap))
+	}
+	return projects, nil
+}
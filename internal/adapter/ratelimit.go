@@ -0,0 +1,185 @@
+package adapter
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	personio "github.com/giantswarm/personio-go/v1"
+)
+
+const (
+	MaxRetriesDefault   int           = 5
+	RetryMaxWaitDefault time.Duration = 30 * time.Second
+
+	retryBaseWait = 500 * time.Millisecond
+
+	headerRetryAfter         = "Retry-After"
+	headerRateLimitRemaining = "X-RateLimit-Remaining"
+	headerRateLimitReset     = "X-RateLimit-Reset"
+)
+
+// rateLimiter spaces out requests to stay below a configured number of
+// requests per minute, and holds back all requests while the Personio
+// rate limit is exhausted.
+type rateLimiter struct {
+	interval time.Duration
+
+	mu         sync.Mutex
+	next       time.Time
+	pauseUntil time.Time
+}
+
+// newRateLimiter creates a limiter for the given number of requests per minute.
+// Zero or a negative number disables client-side spacing of requests.
+func newRateLimiter(requestsPerMinute int) *rateLimiter {
+	l := &rateLimiter{}
+	if requestsPerMinute > 0 {
+		l.interval = time.Minute / time.Duration(requestsPerMinute)
+	}
+	return l
+}
+
+// wait blocks until the next request may be sent or the context is done.
+func (l *rateLimiter) wait(ctx context.Context) error {
+	l.mu.Lock()
+	now := time.Now()
+	at := now
+	if l.next.After(at) {
+		at = l.next
+	}
+	if l.pauseUntil.After(at) {
+		at = l.pauseUntil
+	}
+	l.next = at.Add(l.interval)
+	l.mu.Unlock()
+
+	return sleep(ctx, at.Sub(now))
+}
+
+// observe inspects the rate limit headers of a response and pauses
+// all further requests until the reset if no requests are remaining.
+func (l *rateLimiter) observe(header http.Header) {
+	remaining, err := strconv.Atoi(header.Get(headerRateLimitRemaining))
+	if err != nil || remaining > 0 {
+		return
+	}
+	reset, ok := parseRateLimitReset(header.Get(headerRateLimitReset))
+	if !ok {
+		return
+	}
+	l.mu.Lock()
+	if reset.After(l.pauseUntil) {
+		l.pauseUntil = reset
+	}
+	l.mu.Unlock()
+}
+
+// retryPolicy decides whether and how long to wait before a failed request is retried.
+type retryPolicy struct {
+	maxRetries int
+	maxWait    time.Duration
+}
+
+// shouldRetry reports whether the attempt that failed with err should be retried.
+// Throttled requests, server errors and network errors are retried.
+func (p retryPolicy) shouldRetry(attempt int, resp *http.Response, err error) bool {
+	if attempt >= p.maxRetries {
+		return false
+	}
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	var statusErr personio.StatusError
+	if errors.As(err, &statusErr) {
+		switch statusErr.Code {
+		case http.StatusTooManyRequests,
+			http.StatusInternalServerError,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout:
+			return true
+		}
+		return false
+	}
+	var netErr net.Error
+	return resp == nil && errors.As(err, &netErr)
+}
+
+// backoff returns the time to wait before the next attempt. A delay announced
+// by the API through Retry-After or the rate limit reset takes precedence over
+// the exponential backoff with jitter. The result never exceeds maxWait.
+func (p retryPolicy) backoff(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if d, ok := announcedDelay(resp); ok {
+			return min(d, p.maxWait)
+		}
+	}
+
+	d := p.maxWait
+	if attempt < 32 {
+		d = min(retryBaseWait<<attempt, p.maxWait)
+	}
+	// equal jitter: keep half of the delay, randomise the other half
+	half := d / 2
+	if half <= 0 {
+		return d
+	}
+	return half + time.Duration(rand.Int63n(int64(half)))
+}
+
+// announcedDelay reads the delay requested by the API from the response headers.
+// The rate limit reset is only a delay for throttled requests: other failures,
+// such as a transient 502, are retried with the usual backoff.
+func announcedDelay(resp *http.Response) (time.Duration, bool) {
+	header := resp.Header
+	if v := header.Get(headerRetryAfter); v != "" {
+		if secs, err := strconv.Atoi(v); err == nil {
+			return time.Duration(secs) * time.Second, true
+		}
+		if at, err := http.ParseTime(v); err == nil {
+			return time.Until(at), true
+		}
+	}
+	if resp.StatusCode != http.StatusTooManyRequests {
+		return 0, false
+	}
+	if reset, ok := parseRateLimitReset(header.Get(headerRateLimitReset)); ok {
+		return time.Until(reset), true
+	}
+	return 0, false
+}
+
+// parseRateLimitReset interprets the rate limit reset header, which is either
+// a Unix timestamp or a number of seconds from now.
+func parseRateLimitReset(v string) (time.Time, bool) {
+	n, err := strconv.ParseInt(v, 10, 64)
+	if err != nil || n < 0 {
+		return time.Time{}, false
+	}
+	// values this large can only be timestamps
+	if n > 1_000_000_000 {
+		return time.Unix(n, 0), true
+	}
+	return time.Now().Add(time.Duration(n) * time.Second), true
+}
+
+// sleep waits for the given duration or until the context is done.
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
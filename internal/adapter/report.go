@@ -0,0 +1,118 @@
+package adapter
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	personio "github.com/giantswarm/personio-go/v1"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const customReportsPath = "/company/custom-reports/reports"
+
+// CustomReport is the content of a custom report. Each row maps
+// the column IDs to the cell values, converted to strings.
+type CustomReport struct {
+	Name    types.String
+	Columns []CustomReportColumn
+	Rows    []map[string]types.String
+}
+
+type CustomReportColumn struct {
+	Id    types.String `tfsdk:"id"`
+	Label types.String `tfsdk:"label"`
+	Type  types.String `tfsdk:"type"`
+}
+
+// apiCustomReportPage is a page of the custom report endpoint.
+type apiCustomReportPage struct {
+	Metadata struct {
+		TotalPages int `json:"total_pages"`
+	} `json:"metadata"`
+	Data []struct {
+		Attributes struct {
+			Name    string `json:"name"`
+			Columns []struct {
+				Id    string `json:"id"`
+				Label string `json:"label"`
+				Type  string `json:"type"`
+			} `json:"columns"`
+			Items []struct {
+				Attributes []struct {
+					AttributeId string `json:"attribute_id"`
+					Value       any    `json:"value"`
+				} `json:"attributes"`
+			} `json:"items"`
+		} `json:"attributes"`
+	} `json:"data"`
+}
+
+// GetCustomReport returns the columns and rows of a custom report, following the
+// pagination of the API. Cell values are converted like dynamic attributes,
+// according to the type of their column.
+func (p *PersonioAdapter) GetCustomReport(ctx context.Context, reportId string) (report CustomReport, err error) {
+	path := fmt.Sprintf("%s/%s", customReportsPath, url.PathEscape(reportId))
+	pages, err := cached(p.cache, path, func() ([]apiCustomReportPage, error) {
+		var pages []apiCustomReportPage
+		for page := 1; ; page++ {
+			query := url.Values{}
+			query.Set("page", strconv.Itoa(page))
+			query.Set("limit", strconv.Itoa(pagingMaxLimit))
+			body, err := p.client.get(ctx, path, query)
+			if err != nil {
+				return nil, err
+			}
+			var result apiCustomReportPage
+			if err := decodeJson(body, &result); err != nil {
+				return nil, err
+			}
+			if len(result.Data) == 0 {
+				if page == 1 {
+					return nil, fmt.Errorf("custom report %s returned no data", reportId)
+				}
+				return pages, nil
+			}
+			pages = append(pages, result)
+			if page >= result.Metadata.TotalPages {
+				return pages, nil
+			}
+		}
+	})
+	if err != nil {
+		return report, err
+	}
+
+	first := pages[0].Data[0].Attributes
+	report.Name = types.StringValue(first.Name)
+	columnTypes := make(map[string]string, len(first.Columns))
+	for _, c := range first.Columns {
+		report.Columns = append(report.Columns, CustomReportColumn{
+			Id:    types.StringValue(c.Id),
+			Label: types.StringValue(c.Label),
+			Type:  types.StringValue(c.Type),
+		})
+		columnTypes[c.Id] = c.Type
+	}
+	for _, page := range pages {
+		for _, item := range page.Data[0].Attributes.Items {
+			row := make(map[string]types.String, len(first.Columns))
+			for _, c := range first.Columns {
+				row[c.Id] = types.StringNull()
+			}
+			for _, cell := range item.Attributes {
+				columnType, ok := columnTypes[cell.AttributeId]
+				if !ok {
+					continue
+				}
+				row[cell.AttributeId] = convertAnyAttrToString(personio.Attribute{
+					Type:  columnType,
+					Value: cell.Value,
+				})
+			}
+			report.Rows = append(report.Rows, row)
+		}
+	}
+	return report, nil
+}
@@ -0,0 +1,80 @@
+package adapter
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/jesse0michael/go-rest-assured/assured"
+)
+
+// customReportPage returns a page of a custom report with count
+// rows, whose email column is numbered from first.
+func customReportPage(page int, totalPages int, first int, count int) []byte {
+	items := make([]map[string]any, 0, count)
+	for i := first; i < first+count; i++ {
+		items = append(items, map[string]any{
+			"type": "Employee",
+			"attributes": []map[string]any{
+				{"attribute_id": "email", "value": fmt.Sprintf("employee%d@example.com", i)},
+			},
+		})
+	}
+	body, _ := json.Marshal(map[string]any{
+		"success":  true,
+		"metadata": map[string]any{"current_page": page, "total_pages": totalPages},
+		"data": []map[string]any{{
+			"type": "CustomReport",
+			"attributes": map[string]any{
+				"name":    "Roster",
+				"columns": []map[string]any{{"id": "email", "label": "Email", "type": "standard"}},
+				"items":   items,
+			},
+		}},
+	})
+	return body
+}
+
+func TestGetCustomReportFollowsPagination(t *testing.T) {
+	c := restServerWith(assured.Call{
+		Path:       "/company/custom-reports/reports/roster",
+		Method:     "GET",
+		StatusCode: 200,
+		Response:   customReportPage(1, 2, 1, pagingMaxLimit),
+	}, assured.Call{
+		Path:       "/company/custom-reports/reports/roster",
+		Method:     "GET",
+		StatusCode: 200,
+		Response:   customReportPage(2, 2, 1+pagingMaxLimit, 1),
+	})
+	defer c.Close()
+
+	p := testAdapter(t, c, DefaultAdapterOptions())
+	report, err := p.GetCustomReport(context.Background(), "roster")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(report.Rows) != pagingMaxLimit+1 {
+		t.Fatalf("expected %d rows, got %d", pagingMaxLimit+1, len(report.Rows))
+	}
+	if got := report.Rows[pagingMaxLimit]["email"].ValueString(); got != fmt.Sprintf("employee%d@example.com", pagingMaxLimit+1) {
+		t.Errorf("unexpected email in last row: %s", got)
+	}
+	if len(report.Columns) != 1 || report.Columns[0].Label.ValueString() != "Email" {
+		t.Errorf("unexpected columns %v", report.Columns)
+	}
+
+	calls, err := c.Verify("GET", "company/custom-reports/reports/roster")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(calls) != 2 {
+		t.Fatalf("expected 2 requests, got %d", len(calls))
+	}
+	for i, call := range calls {
+		if want := fmt.Sprint(i + 1); call.Query["page"] != want {
+			t.Errorf("expected page %s in request %d, got %s", want, i, call.Query["page"])
+		}
+	}
+}
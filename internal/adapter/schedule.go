@@ -0,0 +1,135 @@
+package adapter
+
+import (
+	"context"
+	"regexp"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// WorkScheduleSummary is a work schedule with the employees assigned to it.
+// The working hours per weekday are given as decimal hours.
+type WorkScheduleSummary struct {
+	Id          types.Int64   `tfsdk:"id"`
+	Name        types.String  `tfsdk:"name"`
+	ValidFrom   types.String  `tfsdk:"valid_from"`
+	Monday      types.Float64 `tfsdk:"monday"`
+	Tuesday     types.Float64 `tfsdk:"tuesday"`
+	Wednesday   types.Float64 `tfsdk:"wednesday"`
+	Thursday    types.Float64 `tfsdk:"thursday"`
+	Friday      types.Float64 `tfsdk:"friday"`
+	Saturday    types.Float64 `tfsdk:"saturday"`
+	Sunday      types.Float64 `tfsdk:"sunday"`
+	WeeklyHours types.Float64 `tfsdk:"weekly_hours"`
+	EmployeeIds []types.Int64 `tfsdk:"employee_ids"`
+	Headcount   types.Int64   `tfsdk:"headcount"`
+}
+
+// HolidayCalendarSummary is a holiday calendar with the employees assigned to it.
+type HolidayCalendarSummary struct {
+	Id          types.Int64   `tfsdk:"id"`
+	Name        types.String  `tfsdk:"name"`
+	Country     types.String  `tfsdk:"country"`
+	State       types.String  `tfsdk:"state"`
+	EmployeeIds []types.Int64 `tfsdk:"employee_ids"`
+	Headcount   types.Int64   `tfsdk:"headcount"`
+}
+
+// GetWorkSchedules returns the work schedules of the employees that pass the filter,
+// ordered by ID. Work schedules without employees are not known to the API.
+func (p *PersonioAdapter) GetWorkSchedules(ctx context.Context, filter EmployeeFilter) (schedules []WorkScheduleSummary, err error) {
+	employees, err := p.GetEmployees(ctx, filter)
+	if err != nil {
+		return schedules, err
+	}
+	units := groupEmployees(employees, func(e Employee) (types.Int64, types.String) {
+		if e.WorkSchedule == nil {
+			return types.Int64Null(), types.StringNull()
+		}
+		return e.WorkSchedule.Id, e.WorkSchedule.Name
+	})
+	for _, u := range units {
+		ws := u.employees[0].WorkSchedule
+		s := WorkScheduleSummary{
+			Id:          types.Int64Value(u.id),
+			Name:        u.name,
+			ValidFrom:   convertToDate(ws.ValidFrom.ValueStringPointer()),
+			Monday:      scheduleHours(ws.Monday),
+			Tuesday:     scheduleHours(ws.Tuesday),
+			Wednesday:   scheduleHours(ws.Wednesday),
+			Thursday:    scheduleHours(ws.Thursday),
+			Friday:      scheduleHours(ws.Friday),
+			Saturday:    scheduleHours(ws.Saturday),
+			Sunday:      scheduleHours(ws.Sunday),
+			EmployeeIds: u.employeeIds(),
+			Headcount:   types.Int64Value(int64(len(u.employees))),
+		}
+		s.WeeklyHours = weeklyHours(s.Monday, s.Tuesday, s.Wednesday, s.Thursday, s.Friday, s.Saturday, s.Sunday)
+		schedules = append(schedules, s)
+	}
+	return schedules, nil
+}
+
+// GetHolidayCalendars returns the holiday calendars of the employees that pass the filter,
+// ordered by ID. Holiday calendars without employees are not known to the API.
+func (p *PersonioAdapter) GetHolidayCalendars(ctx context.Context, filter EmployeeFilter) (calendars []HolidayCalendarSummary, err error) {
+	employees, err := p.GetEmployees(ctx, filter)
+	if err != nil {
+		return calendars, err
+	}
+	units := groupEmployees(employees, func(e Employee) (types.Int64, types.String) {
+		if e.HolidayCalendar == nil {
+			return types.Int64Null(), types.StringNull()
+		}
+		return e.HolidayCalendar.Id, e.HolidayCalendar.Name
+	})
+	for _, u := range units {
+		hc := u.employees[0].HolidayCalendar
+		calendars = append(calendars, HolidayCalendarSummary{
+			Id:          types.Int64Value(u.id),
+			Name:        u.name,
+			Country:     hc.Country,
+			State:       hc.State,
+			EmployeeIds: u.employeeIds(),
+			Headcount:   types.Int64Value(int64(len(u.employees))),
+		})
+	}
+	return calendars, nil
+}
+
+// scheduleHoursPattern matches the working time of a weekday in HH:MM format.
+var scheduleHoursPattern = regexp.MustCompile(`^(\d{1,2}):(\d{2})$`)
+
+// scheduleHours converts the working time of a weekday in HH:MM format to
+// decimal hours, e.g. 07:30 to 7.5. If the value is null or not a time
+// between 00:00 and 24:00, types.Float64Null is returned.
+func scheduleHours(v types.String) types.Float64 {
+	if v.IsNull() || v.IsUnknown() {
+		return types.Float64Null()
+	}
+	m := scheduleHoursPattern.FindStringSubmatch(v.ValueString())
+	if m == nil {
+		return types.Float64Null()
+	}
+	hours, _ := strconv.Atoi(m[1])
+	minutes, _ := strconv.Atoi(m[2])
+	if minutes >= 60 || hours > 24 || (hours == 24 && minutes > 0) {
+		return types.Float64Null()
+	}
+	return types.Float64Value(float64(hours) + float64(minutes)/60)
+}
+
+// weeklyHours returns the sum of the working hours of the weekdays. If the
+// hours of any day are null, e.g. because they could not be parsed, the sum
+// would be wrong and types.Float64Null is returned.
+func weeklyHours(days ...types.Float64) types.Float64 {
+	var weekly float64
+	for _, h := range days {
+		if h.IsNull() || h.IsUnknown() {
+			return types.Float64Null()
+		}
+		weekly += h.ValueFloat64()
+	}
+	return types.Float64Value(weekly)
+}
@@ -0,0 +1,51 @@
+package adapter
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestScheduleHours(t *testing.T) {
+	for in, want := range map[string]float64{
+		"08:00": 8,
+		"07:30": 7.5,
+		"00:45": 0.75,
+		"00:00": 0,
+		"10:06": 10.1,
+		"8:15":  8.25,
+		"24:00": 24,
+	} {
+		got := scheduleHours(types.StringValue(in))
+		if got.IsNull() || got.ValueFloat64() != want {
+			t.Errorf("expected %s to be %v hours, got %s", in, want, got)
+		}
+	}
+	for _, in := range []types.String{
+		types.StringNull(),
+		types.StringValue(""),
+		types.StringValue("8h"),
+		types.StringValue("07:75"),
+		types.StringValue("-01:00"),
+		types.StringValue("25:00"),
+		types.StringValue("24:30"),
+		types.StringValue("08:00xyz"),
+		types.StringValue(" 08:00"),
+		types.StringValue("08:0"),
+		types.StringValue("123:00"),
+	} {
+		if got := scheduleHours(in); !got.IsNull() {
+			t.Errorf("expected %s to be null, got %s", in, got)
+		}
+	}
+}
+
+func TestWeeklyHours(t *testing.T) {
+	eight, zero := types.Float64Value(8), types.Float64Value(0)
+	if got := weeklyHours(eight, eight, eight, eight, eight, zero, zero); got.ValueFloat64() != 40 {
+		t.Errorf("expected 40 weekly hours, got %s", got)
+	}
+	if got := weeklyHours(eight, eight, types.Float64Null(), eight, eight, zero, zero); !got.IsNull() {
+		t.Errorf("expected null weekly hours if a day is null, got %s", got)
+	}
+}
@@ -0,0 +1,81 @@
+package adapter
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/nicoangelo/terraform-provider-personio/internal/formatter"
+)
+
+// ExpandSupervisors sets the supervisor chain of the employees: the full records
+// of their supervisor, the supervisor's supervisor and so on, up to depth levels.
+// Supervisors among the employees are not requested again. Other supervisors are
+// taken from the list of all employees if it has been fetched before and the
+// response cache is enabled; otherwise each of them costs one request to
+// /company/employees/{id}. The formatters are applied to the supervisors that
+// are not among the employees; the employees themselves must already be formatted.
+// A chain ends early at an employee without supervisor or when it runs into a cycle.
+// It also ends at a supervisor that the API refuses, e.g. because they were
+// terminated or may not be read by the credential. These employees are returned
+// as skipped. Any other error aborts the expansion.
+func (p *PersonioAdapter) ExpandSupervisors(ctx context.Context, employees []Employee, depth int, fc *formatter.FormatterCollection) (skipped []EmployeeError, err error) {
+	if depth <= 0 {
+		return nil, nil
+	}
+	known := make(map[int64]EmployeeRecord, len(employees))
+	for _, e := range employees {
+		if !e.Id.IsNull() {
+			known[e.Id.ValueInt64()] = e.EmployeeRecord
+		}
+	}
+	refused := map[int64]error{}
+	resolve := func(id int64) (EmployeeRecord, error) {
+		if s, ok := known[id]; ok {
+			return s, nil
+		}
+		if err, ok := refused[id]; ok {
+			return EmployeeRecord{}, err
+		}
+		e, err := p.GetEmployee(ctx, id)
+		if isRefused(err) {
+			refused[id] = err
+		}
+		if err != nil {
+			return EmployeeRecord{}, err
+		}
+		e.ApplyFormats(fc)
+		known[id] = e.EmployeeRecord
+		return e.EmployeeRecord, nil
+	}
+
+	for i := range employees {
+		e := &employees[i]
+		chain := []EmployeeRecord{}
+		seen := map[int64]bool{e.Id.ValueInt64(): true}
+		next := supervisorId(e.Profile)
+		for len(chain) < depth && next != nil && !seen[*next] {
+			s, err := resolve(*next)
+			if isRefused(err) {
+				skipped = append(skipped, EmployeeError{EmployeeId: e.Id.ValueInt64(), Err: fmt.Errorf("supervisor %d: %w", *next, err)})
+				break
+			}
+			if err != nil {
+				return nil, fmt.Errorf("supervisor %d of employee %d: %w", *next, e.Id.ValueInt64(), err)
+			}
+			chain = append(chain, s)
+			seen[*next] = true
+			next = supervisorId(s.Profile)
+		}
+		e.SupervisorChain = chain
+	}
+	return skipped, nil
+}
+
+// supervisorId returns the ID of the supervisor in the profile, or nil
+// if there is no supervisor.
+func supervisorId(profile *EmployeeProfile) *int64 {
+	if profile == nil || profile.Supervisor == nil {
+		return nil
+	}
+	return profile.Supervisor.Id.ValueInt64Pointer()
+}
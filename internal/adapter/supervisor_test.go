@@ -0,0 +1,57 @@
+package adapter
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/jesse0michael/go-rest-assured/assured"
+	"github.com/nicoangelo/terraform-provider-personio/internal/formatter"
+)
+
+func TestExpandSupervisorsStopsAtRefusedSupervisor(t *testing.T) {
+	supervisor, _ := os.ReadFile("../../test/data/supervisor_employee.json")
+	c := restServerWith(assured.Call{
+		Path:       "/company/employees/13649274",
+		Method:     "GET",
+		StatusCode: 200,
+		Response:   supervisor,
+	}, assured.Call{
+		Path:       "/company/employees/13649262",
+		Method:     "GET",
+		StatusCode: 404,
+	})
+	defer c.Close()
+	p := testAdapter(t, c, DefaultAdapterOptions())
+
+	employees := []Employee{orgChartEmployee(13649293, 13649274)}
+	skipped, err := p.ExpandSupervisors(context.Background(), employees, 3, &formatter.FormatterCollection{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := len(employees[0].SupervisorChain); got != 1 {
+		t.Fatalf("expected the chain to end after 1 supervisor, got %d", got)
+	}
+	if got := employees[0].SupervisorChain[0].Id.ValueInt64(); got != 13649274 {
+		t.Errorf("expected supervisor 13649274, got %d", got)
+	}
+	if len(skipped) != 1 || skipped[0].EmployeeId != 13649293 {
+		t.Fatalf("expected employee 13649293 to be reported, got %v", skipped)
+	}
+}
+
+func TestExpandSupervisorsReportsFailingSupervisor(t *testing.T) {
+	c := restServerWith(assured.Call{
+		Path:       "/company/employees/13649274",
+		Method:     "GET",
+		StatusCode: 502,
+	})
+	defer c.Close()
+	p := testAdapter(t, c, AdapterOptions{RetryMaxWait: 10 * time.Millisecond})
+
+	employees := []Employee{orgChartEmployee(13649293, 13649274)}
+	if _, err := p.ExpandSupervisors(context.Background(), employees, 1, &formatter.FormatterCollection{}); err == nil {
+		t.Fatal("expected an error")
+	}
+}
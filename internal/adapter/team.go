@@ -0,0 +1,68 @@
+package adapter
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type Team struct {
+	Id             types.Int64    `tfsdk:"id"`
+	Name           types.String   `tfsdk:"name"`
+	EmployeeIds    []types.Int64  `tfsdk:"employee_ids"`
+	EmployeeEmails []types.String `tfsdk:"employee_emails"`
+	Headcount      types.Int64    `tfsdk:"headcount"`
+	Lead           *Supervisor    `tfsdk:"lead"`
+}
+
+// GetTeams returns the teams of the employees that pass the filter, ordered by ID.
+// Teams without employees are not known to the API.
+func (p *PersonioAdapter) GetTeams(ctx context.Context, filter EmployeeFilter) (teams []Team, err error) {
+	employees, err := p.GetEmployees(ctx, filter)
+	if err != nil {
+		return teams, err
+	}
+	units := groupEmployees(employees, func(e Employee) (types.Int64, types.String) {
+		return e.Profile.TeamId, e.Profile.Team
+	})
+	for _, u := range units {
+		emails := make([]types.String, 0, len(u.employees))
+		for _, e := range u.employees {
+			emails = append(emails, e.Email)
+		}
+		teams = append(teams, Team{
+			Id:             types.Int64Value(u.id),
+			Name:           u.name,
+			EmployeeIds:    u.employeeIds(),
+			EmployeeEmails: emails,
+			Headcount:      types.Int64Value(int64(len(u.employees))),
+			Lead:           teamLead(u.employees),
+		})
+	}
+	return teams, nil
+}
+
+// teamLead derives the lead of a team as the supervisor of most of its
+// employees. Ties are resolved in favour of the supervisor with the lowest ID.
+// If no employee has a supervisor, nil is returned.
+func teamLead(employees []Employee) *Supervisor {
+	counts := map[int64]int{}
+	var lead *Supervisor
+	for _, e := range employees {
+		s := e.Profile.Supervisor
+		if s == nil || s.Id.IsNull() {
+			continue
+		}
+		id := s.Id.ValueInt64()
+		counts[id]++
+		if lead == nil {
+			lead = s
+			continue
+		}
+		leadId := lead.Id.ValueInt64()
+		if counts[id] > counts[leadId] || (counts[id] == counts[leadId] && id < leadId) {
+			lead = s
+		}
+	}
+	return lead
+}
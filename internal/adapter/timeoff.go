@@ -0,0 +1,65 @@
+package adapter
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type TimeOffType struct {
+	Id               types.Int64  `tfsdk:"id"`
+	Name             types.String `tfsdk:"name"`
+	Category         types.String `tfsdk:"category"`
+	Unit             types.String `tfsdk:"unit"`
+	HalfDaysEnabled  types.Bool   `tfsdk:"half_days_enabled"`
+	ApprovalRequired types.Bool   `tfsdk:"approval_required"`
+}
+
+// apiTimeOffType is an item of the time-off types endpoint.
+type apiTimeOffType struct {
+	Attributes struct {
+		Id                     int64   `json:"id"`
+		Name                   string  `json:"name"`
+		Category               *string `json:"category"`
+		Unit                   *string `json:"unit"`
+		HalfDayRequestsEnabled *bool   `json:"half_day_requests_enabled"`
+		ApprovalRequired       *bool   `json:"approval_required"`
+	} `json:"attributes"`
+}
+
+func NewTimeOffType(t apiTimeOffType) TimeOffType {
+	return TimeOffType{
+		Id:               types.Int64Value(t.Attributes.Id),
+		Name:             types.StringValue(t.Attributes.Name),
+		Category:         types.StringPointerValue(t.Attributes.Category),
+		Unit:             types.StringPointerValue(t.Attributes.Unit),
+		HalfDaysEnabled:  types.BoolPointerValue(t.Attributes.HalfDayRequestsEnabled),
+		ApprovalRequired: types.BoolPointerValue(t.Attributes.ApprovalRequired),
+	}
+}
+
+// GetTimeOffTypes returns the time-off types (e.g. paid vacation, sick leave) of the tenant.
+func (p *PersonioAdapter) GetTimeOffTypes(ctx context.Context) (timeOffTypes []TimeOffType, err error) {
+	apiTypes, err := cached(p.cache, timeOffTypesCacheKey, func() ([]apiTimeOffType, error) {
+		items, err := p.client.getPages(ctx, "/company/time-off-types", nil, offsetByItem)
+		if err != nil {
+			return nil, err
+		}
+		res := make([]apiTimeOffType, 0, len(items))
+		for _, item := range items {
+			var t apiTimeOffType
+			if err := decodeJson(item, &t); err != nil {
+				return nil, err
+			}
+			res = append(res, t)
+		}
+		return res, nil
+	})
+	if err != nil {
+		return timeOffTypes, err
+	}
+	for _, t := range apiTypes {
+		timeOffTypes = append(timeOffTypes, NewTimeOffType(t))
+	}
+	return timeOffTypes, nil
+}
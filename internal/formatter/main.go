@@ -15,6 +15,12 @@ type Formatter struct {
 	attributeKey string
 }
 
+// matches reports whether the formatter applies to the attribute
+// with the given key or label.
+func (f Formatter) matches(key string, label string) bool {
+	return f.attributeKey == key || (label != "" && f.attributeKey == label)
+}
+
 func (fc *FormatterCollection) FromConfig(cfg []FormatterConfig) {
 	fc.formatters = []Formatter{}
 
@@ -35,17 +41,25 @@ func (fc *FormatterCollection) FromConfig(cfg []FormatterConfig) {
 }
 
 // FormatAll runs all registered formatters on the dynamic attributes.
-// If a attribute key does not exist, or the value is null or unknown,
-// no changes are made.
-func (fc *FormatterCollection) FormatAll(attrs map[string]types.String) {
+// Formatters are matched by attribute key, or by the label of the attribute
+// given in labels. If a attribute does not exist, or the value is null or
+// unknown, no changes are made.
+func (fc *FormatterCollection) FormatAll(attrs map[string]types.String, labels map[string]string) {
+	for k, attr := range attrs {
+		attrs[k] = fc.Format(k, labels[k], attr)
+	}
+}
+
+// Format runs all formatters registered for the attribute key or label on the value.
+// Null or unknown values are returned unchanged.
+func (fc *FormatterCollection) Format(key string, label string, attr types.String) types.String {
 	for _, v := range fc.formatters {
-		attr, ok := attrs[v.attributeKey]
-		if !ok || attr.IsNull() || attr.IsUnknown() {
+		if !v.matches(key, label) || attr.IsNull() || attr.IsUnknown() {
 			continue
 		}
-
-		attrs[v.attributeKey] = types.StringValue(v.formatter.Format(attr.ValueString()))
+		attr = types.StringValue(v.formatter.Format(attr.ValueString()))
 	}
+	return attr
 }
 
 type FormatterConfig struct {
@@ -0,0 +1,133 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/nicoangelo/terraform-provider-personio/internal/adapter"
+	"github.com/nicoangelo/terraform-provider-personio/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var (
+	_ datasource.DataSource = &AbsenceBalancesDataSource{}
+)
+
+func NewAbsenceBalancesDataSource() datasource.DataSource {
+	return &AbsenceBalancesDataSource{}
+}
+
+// AbsenceBalancesDataSource defines the data source implementation.
+type AbsenceBalancesDataSource struct {
+	client *adapter.PersonioAdapter
+}
+
+// AbsenceBalancesDataSourceModel describes the data source data model.
+type AbsenceBalancesDataSourceModel struct {
+	Balances   []adapter.AbsenceBalance `tfsdk:"balances"`
+	EmployeeId types.Int64              `tfsdk:"employee_id"`
+	Id         types.String             `tfsdk:"id"`
+}
+
+func (d *AbsenceBalancesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_absence_balances"
+}
+
+func (d *AbsenceBalancesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: `
+Absence balances data source
+
+Retrieves the absence balances of employees for every time-off type. If no ` + "`employee_id`" + ` is given,
+the balances of all employees are retrieved. This requires one request per employee, which are sent
+with a bounded concurrency and are subject to the rate limiting of the provider. Employees whose balances
+are refused by Personio, e.g. because the API credential may not read them, are left out with a warning.
+
+The balances are ordered by employee in the order of the employee list, then in the order of the Personio API.
+`,
+		Attributes: map[string]schema.Attribute{
+			"balances": schema.ListNestedAttribute{
+				MarkdownDescription: "List of absence balances, one per employee and time-off type.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: absenceBalanceAttributes,
+				},
+			},
+			"employee_id": schema.Int64Attribute{
+				MarkdownDescription: "Only return the balances of the employee with this ID.",
+				Optional:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier derived from a hash of the arguments and the returned balances. It only changes when either of them changes.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *AbsenceBalancesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*adapter.PersonioAdapter)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *adapter.PersonioAdapter, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *AbsenceBalancesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data AbsenceBalancesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.EmployeeId.IsNull() {
+		balances, err := d.client.GetAbsenceBalances(ctx, data.EmployeeId.ValueInt64())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read absence balances, got error: %s", err))
+			return
+		}
+		data.Balances = balances
+	} else {
+		employees, err := d.client.GetEmployees(ctx, adapter.EmployeeFilter{})
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read employees, got error: %s", err))
+			return
+		}
+		var employeeIds []int64
+		for _, e := range employees {
+			employeeIds = append(employeeIds, e.Id.ValueInt64())
+		}
+
+		balances, skipped, err := d.client.GetAbsenceBalancesOf(ctx, employeeIds)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read absence balances, got error: %s", err))
+			return
+		}
+		for _, e := range skipped {
+			resp.Diagnostics.AddWarning("Absence Balances Skipped",
+				fmt.Sprintf("The absence balances of employee %d were refused by Personio and are left out: %s", e.EmployeeId, e.Err))
+		}
+		data.Balances = balances
+	}
+	data.Id = utils.GetStableId("personio_absence_balances", data)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
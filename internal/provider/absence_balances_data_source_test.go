@@ -0,0 +1,127 @@
+package provider
+
+import (
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/jesse0michael/go-rest-assured/assured"
+)
+
+const (
+	testAccAbsenceBalancesDataSourceConfig = `
+data "personio_absence_balances" "test" {
+	employee_id = 13649297
+}
+`
+	testAccAbsenceBalancesOfAllDataSourceConfig = `
+data "personio_absence_balances" "test" {
+}
+`
+	testAccAbsenceBalancesRefusedDataSourceConfig = `
+data "personio_absence_balances" "test" {
+	employee_id = 13649293
+}
+`
+)
+
+func TestAccAbsenceBalancesDataSource(t *testing.T) {
+	balances, _ := os.ReadFile("../../test/data/absence_balances.json")
+	employees, _ := os.ReadFile("../../test/data/balance_employees.json")
+	c := DefaultRestServerWith(assured.Call{
+		Path:       "/company/employees/13649297/absences/balance",
+		Method:     "GET",
+		StatusCode: 200,
+		Response:   balances,
+	}, assured.Call{
+		Path:       "/company/employees/13649293/absences/balance",
+		Method:     "GET",
+		StatusCode: 200,
+		Response:   balances,
+	}, assured.Call{
+		Path:       "/company/employees",
+		Method:     "GET",
+		StatusCode: 200,
+		Response:   employees,
+	})
+	defer c.Close()
+	t.Setenv("PERSONIO_API_URL", c.URL())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccAbsenceBalancesDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.personio_absence_balances.test", "balances.#", "3"),
+					resource.TestCheckResourceAttr("data.personio_absence_balances.test", "balances.0.employee_id", employeeId),
+					resource.TestCheckResourceAttr("data.personio_absence_balances.test", "balances.0.time_off_type_id", "2179197"),
+					resource.TestCheckResourceAttr("data.personio_absence_balances.test", "balances.0.name", "Paid vacation"),
+					resource.TestCheckResourceAttr("data.personio_absence_balances.test", "balances.0.category", "paid_vacation"),
+					resource.TestCheckResourceAttr("data.personio_absence_balances.test", "balances.0.balance", "12.5"),
+					resource.TestCheckResourceAttr("data.personio_absence_balances.test", "balances.1.balance", "0"),
+					resource.TestCheckNoResourceAttr("data.personio_absence_balances.test", "balances.2.category"),
+					resource.TestCheckResourceAttr("data.personio_absence_balances.test", "balances.2.balance", "-1.5"),
+				),
+			},
+			{
+				Config: testAccAbsenceBalancesOfAllDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.personio_absence_balances.test", "balances.#", "6"),
+					resource.TestCheckResourceAttr("data.personio_absence_balances.test", "balances.0.employee_id", employeeId),
+					resource.TestCheckResourceAttr("data.personio_absence_balances.test", "balances.3.employee_id", "13649293"),
+					resource.TestCheckResourceAttr("data.personio_absence_balances.test", "balances.5.employee_id", "13649293"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAbsenceBalancesDataSourceSkipsRefusedEmployees(t *testing.T) {
+	balances, _ := os.ReadFile("../../test/data/absence_balances.json")
+	employees, _ := os.ReadFile("../../test/data/balance_employees.json")
+	c := DefaultRestServerWith(assured.Call{
+		Path:       "/company/employees/13649297/absences/balance",
+		Method:     "GET",
+		StatusCode: 200,
+		Response:   balances,
+	}, assured.Call{
+		Path:       "/company/employees/13649293/absences/balance",
+		Method:     "GET",
+		StatusCode: 403,
+		Response:   []byte(`{"success": false, "error": {"code": 403, "message": "Forbidden"}}`),
+	}, assured.Call{
+		Path:       "/company/employees",
+		Method:     "GET",
+		StatusCode: 200,
+		Response:   employees,
+	})
+	defer c.Close()
+	t.Setenv("PERSONIO_API_URL", c.URL())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+
+		Steps: []resource.TestStep{
+			// Must fail
+			{
+				Config:      testAccAbsenceBalancesRefusedDataSourceConfig,
+				ExpectError: regexp.MustCompile(`Unable to read absence balances, got error: 403 Forbidden`),
+			},
+
+			// Read testing
+			{
+				Config: testAccAbsenceBalancesOfAllDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.personio_absence_balances.test", "balances.#", "3"),
+					resource.TestCheckResourceAttr("data.personio_absence_balances.test", "balances.0.employee_id", employeeId),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,144 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/nicoangelo/terraform-provider-personio/internal/adapter"
+	"github.com/nicoangelo/terraform-provider-personio/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var (
+	_ datasource.DataSource = &AbsencesDataSource{}
+)
+
+func NewAbsencesDataSource() datasource.DataSource {
+	return &AbsencesDataSource{}
+}
+
+// AbsencesDataSource defines the data source implementation.
+type AbsencesDataSource struct {
+	client *adapter.PersonioAdapter
+}
+
+// AbsencesDataSourceModel describes the data source data model.
+type AbsencesDataSourceModel struct {
+	Absences       []adapter.Absence `tfsdk:"absences"`
+	StartDate      types.String      `tfsdk:"start_date"`
+	EndDate        types.String      `tfsdk:"end_date"`
+	EmployeeIds    []types.Int64     `tfsdk:"employee_ids"`
+	TimeOffTypeIds []types.Int64     `tfsdk:"time_off_type_ids"`
+	Id             types.String      `tfsdk:"id"`
+}
+
+func (d *AbsencesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_absences"
+}
+
+func (d *AbsencesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: `
+Absences data source
+
+Retrieves the absences (time-off periods) that overlap with a period of time, e.g. to find out who is out
+when generating on-call schedules. The absences can be narrowed down to certain employees and time-off types.
+
+Absences measured in hours are not returned by the Personio API.
+`,
+		Attributes: map[string]schema.Attribute{
+			"absences": schema.ListNestedAttribute{
+				MarkdownDescription: "List of absences.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: absenceAttributes,
+				},
+			},
+			"start_date": schema.StringAttribute{
+				MarkdownDescription: "First day of the period (YYYY-MM-DD).",
+				Required:            true,
+				Validators:          []validator.String{dateValidator},
+			},
+			"end_date": schema.StringAttribute{
+				MarkdownDescription: "Last day of the period (YYYY-MM-DD). Must not be before `start_date`.",
+				Required:            true,
+				Validators:          []validator.String{dateValidator},
+			},
+			"employee_ids": schema.ListAttribute{
+				MarkdownDescription: "Only return absences of the employees with these IDs.",
+				ElementType:         types.Int64Type,
+				Optional:            true,
+			},
+			"time_off_type_ids": schema.ListAttribute{
+				MarkdownDescription: "Only return absences of the time-off types with these IDs.",
+				ElementType:         types.Int64Type,
+				Optional:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier derived from a hash of the arguments and the returned absences. It only changes when either of them changes.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *AbsencesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*adapter.PersonioAdapter)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *adapter.PersonioAdapter, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *AbsencesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data AbsencesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter, diags := data.filter()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	absences, err := d.client.GetAbsences(ctx, filter)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read absences, got error: %s", err))
+		return
+	}
+
+	data.Absences = absences
+	data.Id = utils.GetStableId("personio_absences", data)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// filter converts the arguments to an adapter.AbsenceFilter.
+func (m AbsencesDataSourceModel) filter() (f adapter.AbsenceFilter, diags diag.Diagnostics) {
+	f.StartDate, f.EndDate, diags = parsePeriod(m.StartDate, m.EndDate)
+	f.EmployeeIds = int64Values(m.EmployeeIds)
+	f.TimeOffTypeIds = int64Values(m.TimeOffTypeIds)
+	return f, diags
+}
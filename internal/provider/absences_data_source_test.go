@@ -0,0 +1,103 @@
+package provider
+
+import (
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/jesse0michael/go-rest-assured/assured"
+)
+
+const (
+	testAccAbsencesDataSourceConfig = `
+data "personio_absences" "test" {
+	start_date = "2024-08-01"
+	end_date   = "2024-08-31"
+}
+`
+	testAccAbsencesFilteredDataSourceConfig = `
+data "personio_absences" "test" {
+	start_date        = "2024-08-01"
+	end_date          = "2024-08-31"
+	employee_ids      = [13649297]
+	time_off_type_ids = [2179197]
+}
+`
+	testAccAbsencesInvalidPeriodDataSourceConfig = `
+data "personio_absences" "test" {
+	start_date = "2024-08-31"
+	end_date   = "2024-08-01"
+}
+`
+	testAccAbsencesInvalidDateDataSourceConfig = `
+data "personio_absences" "test" {
+	start_date = "01.08.2024"
+	end_date   = "2024-08-31"
+}
+`
+)
+
+func TestAccAbsencesDataSource(t *testing.T) {
+	absences, _ := os.ReadFile("../../test/data/absences.json")
+	c := DefaultRestServerWith(assured.Call{
+		Path:       "/company/time-offs",
+		Method:     "GET",
+		StatusCode: 200,
+		Response:   absences,
+	})
+	defer c.Close()
+	t.Setenv("PERSONIO_API_URL", c.URL())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+
+		Steps: []resource.TestStep{
+			// Must fail
+			{
+				Config:      testAccAbsencesInvalidPeriodDataSourceConfig,
+				ExpectError: regexp.MustCompile(`The end date 2024-08-01 must not be before the start date 2024-08-31`),
+			},
+			{
+				Config:      testAccAbsencesInvalidDateDataSourceConfig,
+				ExpectError: regexp.MustCompile(`must be a date in YYYY-MM-DD format`),
+			},
+
+			// Read testing
+			{
+				Config: testAccAbsencesDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.personio_absences.test", "absences.#", "3"),
+					resource.TestCheckResourceAttr("data.personio_absences.test", "absences.0.id", "498412001"),
+					resource.TestCheckResourceAttr("data.personio_absences.test", "absences.0.employee_id", employeeId),
+					resource.TestCheckResourceAttr("data.personio_absences.test", "absences.0.status", "approved"),
+					resource.TestCheckResourceAttr("data.personio_absences.test", "absences.0.comment", "Summer holidays"),
+					resource.TestCheckResourceAttr("data.personio_absences.test", "absences.0.start_date", "2024-07-29"),
+					resource.TestCheckResourceAttr("data.personio_absences.test", "absences.0.end_date", "2024-08-09"),
+					resource.TestCheckResourceAttr("data.personio_absences.test", "absences.0.days_count", "10"),
+					resource.TestCheckResourceAttr("data.personio_absences.test", "absences.0.half_day_start", "false"),
+					resource.TestCheckResourceAttr("data.personio_absences.test", "absences.0.time_off_type.id", "2179197"),
+					resource.TestCheckResourceAttr("data.personio_absences.test", "absences.0.time_off_type.name", "Paid vacation"),
+					resource.TestCheckResourceAttr("data.personio_absences.test", "absences.0.created_at", "2024-05-02T08:15:00Z"),
+					resource.TestCheckResourceAttr("data.personio_absences.test", "absences.1.status", "pending"),
+					resource.TestCheckResourceAttr("data.personio_absences.test", "absences.1.days_count", "1.5"),
+					resource.TestCheckResourceAttr("data.personio_absences.test", "absences.1.half_day_end", "true"),
+					resource.TestCheckResourceAttr("data.personio_absences.test", "absences.2.half_day_start", "true"),
+					resource.TestCheckNoResourceAttr("data.personio_absences.test", "absences.2.comment"),
+					resource.TestCheckResourceAttr("data.personio_absences.test", "absences.2.time_off_type.category", "sick_leave"),
+					testCheckQueryParameterSent(c, "company/time-offs", "start_date", "2024-08-01"),
+					testCheckQueryParameterSent(c, "company/time-offs", "end_date", "2024-08-31"),
+					testCheckQueryParameterSent(c, "company/time-offs", "limit", "100"),
+				),
+			},
+			{
+				Config: testAccAbsencesFilteredDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testCheckQueryParameterSent(c, "company/time-offs", "employees[]", employeeId),
+					testCheckQueryParameterSent(c, "company/time-offs", "time_off_types[]", "2179197"),
+				),
+			},
+		},
+	})
+}
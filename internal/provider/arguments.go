@@ -0,0 +1,45 @@
+package provider
+
+import (
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/nicoangelo/terraform-provider-personio/internal/utils"
+)
+
+// dateValidator checks that a string argument is a date in YYYY-MM-DD format.
+var dateValidator = stringvalidator.RegexMatches(regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`), "must be a date in YYYY-MM-DD format")
+
+// parsePeriod parses the start_date and end_date arguments of a data source
+// and verifies that the period does not end before it starts.
+func parsePeriod(startDate types.String, endDate types.String) (start time.Time, end time.Time, diags diag.Diagnostics) {
+	start, err := time.Parse(utils.DateFormat, startDate.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("start_date"), "Invalid Attribute Value", err.Error())
+	}
+	end, err = time.Parse(utils.DateFormat, endDate.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("end_date"), "Invalid Attribute Value", err.Error())
+	}
+	if !diags.HasError() && end.Before(start) {
+		diags.AddAttributeError(path.Root("end_date"), "Invalid Attribute Value",
+			"The end date "+endDate.ValueString()+" must not be before the start date "+startDate.ValueString()+".")
+	}
+	return start, end, diags
+}
+
+// int64Values returns the values of a list argument of integers.
+func int64Values(values []types.Int64) []int64 {
+	if len(values) == 0 {
+		return nil
+	}
+	res := make([]int64, 0, len(values))
+	for _, v := range values {
+		res = append(res, v.ValueInt64())
+	}
+	return res
+}
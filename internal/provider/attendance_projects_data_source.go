@@ -0,0 +1,113 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/nicoangelo/terraform-provider-personio/internal/adapter"
+	"github.com/nicoangelo/terraform-provider-personio/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var (
+	_ datasource.DataSource = &AttendanceProjectsDataSource{}
+)
+
+func NewAttendanceProjectsDataSource() datasource.DataSource {
+	return &AttendanceProjectsDataSource{}
+}
+
+// AttendanceProjectsDataSource defines the data source implementation.
+type AttendanceProjectsDataSource struct {
+	client *adapter.PersonioAdapter
+}
+
+// AttendanceProjectsDataSourceModel describes the data source data model.
+type AttendanceProjectsDataSourceModel struct {
+	Projects []adapter.AttendanceProject `tfsdk:"projects"`
+	Active   types.Bool                  `tfsdk:"active"`
+	Id       types.String                `tfsdk:"id"`
+}
+
+func (d *AttendanceProjectsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_attendance_projects"
+}
+
+func (d *AttendanceProjectsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: `
+Attendance projects data source
+
+Retrieves the projects that attendances can be recorded for, e.g. to keep the project lists of other
+systems in sync with Personio. The ` + "`project_id`" + ` of ` + "`personio_attendances`" + ` refers to these projects.
+`,
+		Attributes: map[string]schema.Attribute{
+			"projects": schema.ListNestedAttribute{
+				MarkdownDescription: "List of attendance projects.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: attendanceProjectAttributes,
+				},
+			},
+			"active": schema.BoolAttribute{
+				MarkdownDescription: "Only return active (`true`) or inactive (`false`) projects. All projects are returned if not set. " +
+					"Projects whose status is not known are only returned if not set.",
+				Optional: true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier derived from a hash of the arguments and the returned projects. It only changes when either of them changes.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *AttendanceProjectsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*adapter.PersonioAdapter)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *adapter.PersonioAdapter, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *AttendanceProjectsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data AttendanceProjectsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projects, err := d.client.GetAttendanceProjects(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read attendance projects, got error: %s", err))
+		return
+	}
+
+	for _, p := range projects {
+		// a project without active flag matches neither filter value
+		if data.Active.IsNull() || (!p.Active.IsNull() && p.Active.ValueBool() == data.Active.ValueBool()) {
+			data.Projects = append(data.Projects, p)
+		}
+	}
+	data.Id = utils.GetStableId("personio_attendance_projects", data)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,74 @@
+package provider
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/jesse0michael/go-rest-assured/assured"
+)
+
+const (
+	testAccAttendanceProjectsDataSourceConfig = `
+data "personio_attendance_projects" "test" {
+}
+`
+	testAccAttendanceProjectsActiveDataSourceConfig = `
+data "personio_attendance_projects" "test" {
+	active = true
+}
+`
+	testAccAttendanceProjectsInactiveDataSourceConfig = `
+data "personio_attendance_projects" "test" {
+	active = false
+}
+`
+)
+
+func TestAccAttendanceProjectsDataSource(t *testing.T) {
+	projects, _ := os.ReadFile("../../test/data/attendance_projects.json")
+	c := DefaultRestServerWith(assured.Call{
+		Path:       "/company/attendances/projects",
+		Method:     "GET",
+		StatusCode: 200,
+		Response:   projects,
+	})
+	defer c.Close()
+	t.Setenv("PERSONIO_API_URL", c.URL())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccAttendanceProjectsDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.personio_attendance_projects.test", "projects.#", "4"),
+					resource.TestCheckNoResourceAttr("data.personio_attendance_projects.test", "projects.3.active"),
+					resource.TestCheckResourceAttr("data.personio_attendance_projects.test", "projects.0.id", "5301"),
+					resource.TestCheckResourceAttr("data.personio_attendance_projects.test", "projects.0.name", "Website relaunch"),
+					resource.TestCheckResourceAttr("data.personio_attendance_projects.test", "projects.0.active", "true"),
+					resource.TestCheckResourceAttr("data.personio_attendance_projects.test", "projects.0.created_at", "2024-02-12T08:30:00Z"),
+					resource.TestCheckResourceAttr("data.personio_attendance_projects.test", "projects.0.updated_at", "2024-06-03T12:02:11Z"),
+				),
+			},
+			{
+				Config: testAccAttendanceProjectsActiveDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.personio_attendance_projects.test", "projects.#", "2"),
+					resource.TestCheckResourceAttr("data.personio_attendance_projects.test", "projects.1.id", "5302"),
+				),
+			},
+			{
+				Config: testAccAttendanceProjectsInactiveDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.personio_attendance_projects.test", "projects.#", "1"),
+					resource.TestCheckResourceAttr("data.personio_attendance_projects.test", "projects.0.id", "5298"),
+					resource.TestCheckResourceAttr("data.personio_attendance_projects.test", "projects.0.active", "false"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,142 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/nicoangelo/terraform-provider-personio/internal/adapter"
+	"github.com/nicoangelo/terraform-provider-personio/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var (
+	_ datasource.DataSource = &AttendancesDataSource{}
+)
+
+func NewAttendancesDataSource() datasource.DataSource {
+	return &AttendancesDataSource{}
+}
+
+// AttendancesDataSource defines the data source implementation.
+type AttendancesDataSource struct {
+	client *adapter.PersonioAdapter
+}
+
+// AttendancesDataSourceModel describes the data source data model.
+type AttendancesDataSourceModel struct {
+	Attendances    []adapter.Attendance `tfsdk:"attendances"`
+	StartDate      types.String         `tfsdk:"start_date"`
+	EndDate        types.String         `tfsdk:"end_date"`
+	EmployeeIds    []types.Int64        `tfsdk:"employee_ids"`
+	IncludePending types.Bool           `tfsdk:"include_pending"`
+	Id             types.String         `tfsdk:"id"`
+}
+
+func (d *AttendancesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_attendances"
+}
+
+func (d *AttendancesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: `
+Attendances data source
+
+Retrieves the attendance records of a period of time, e.g. to reconcile the recorded working time with
+project billing. The records can be narrowed down to certain employees. Attendances that are pending
+approval are only returned if ` + "`include_pending`" + ` is set.
+`,
+		Attributes: map[string]schema.Attribute{
+			"attendances": schema.ListNestedAttribute{
+				MarkdownDescription: "List of attendances.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: attendanceAttributes,
+				},
+			},
+			"start_date": schema.StringAttribute{
+				MarkdownDescription: "First day of the period (YYYY-MM-DD).",
+				Required:            true,
+				Validators:          []validator.String{dateValidator},
+			},
+			"end_date": schema.StringAttribute{
+				MarkdownDescription: "Last day of the period (YYYY-MM-DD). Must not be before `start_date`.",
+				Required:            true,
+				Validators:          []validator.String{dateValidator},
+			},
+			"employee_ids": schema.ListAttribute{
+				MarkdownDescription: "Only return attendances of the employees with these IDs.",
+				ElementType:         types.Int64Type,
+				Optional:            true,
+			},
+			"include_pending": schema.BoolAttribute{
+				MarkdownDescription: "Also return attendances that are pending approval. Defaults to `false`.",
+				Optional:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier derived from a hash of the arguments and the returned attendances. It only changes when either of them changes.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *AttendancesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*adapter.PersonioAdapter)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *adapter.PersonioAdapter, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *AttendancesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data AttendancesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter, diags := data.filter()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	attendances, err := d.client.GetAttendances(ctx, filter)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read attendances, got error: %s", err))
+		return
+	}
+
+	data.Attendances = attendances
+	data.Id = utils.GetStableId("personio_attendances", data)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// filter converts the arguments to an adapter.AttendanceFilter.
+func (m AttendancesDataSourceModel) filter() (f adapter.AttendanceFilter, diags diag.Diagnostics) {
+	f.StartDate, f.EndDate, diags = parsePeriod(m.StartDate, m.EndDate)
+	f.EmployeeIds = int64Values(m.EmployeeIds)
+	f.IncludePending = m.IncludePending.ValueBool()
+	return f, diags
+}
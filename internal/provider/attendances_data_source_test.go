@@ -0,0 +1,90 @@
+package provider
+
+import (
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/jesse0michael/go-rest-assured/assured"
+)
+
+const (
+	testAccAttendancesDataSourceConfig = `
+data "personio_attendances" "test" {
+	start_date = "2024-08-01"
+	end_date   = "2024-08-31"
+}
+`
+	testAccAttendancesFilteredDataSourceConfig = `
+data "personio_attendances" "test" {
+	start_date      = "2024-08-01"
+	end_date        = "2024-08-31"
+	employee_ids    = [13649297]
+	include_pending = true
+}
+`
+	testAccAttendancesInvalidPeriodDataSourceConfig = `
+data "personio_attendances" "test" {
+	start_date = "2024-08-31"
+	end_date   = "2024-08-01"
+}
+`
+)
+
+func TestAccAttendancesDataSource(t *testing.T) {
+	attendances, _ := os.ReadFile("../../test/data/attendances.json")
+	c := DefaultRestServerWith(assured.Call{
+		Path:       "/company/attendances",
+		Method:     "GET",
+		StatusCode: 200,
+		Response:   attendances,
+	})
+	defer c.Close()
+	t.Setenv("PERSONIO_API_URL", c.URL())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+
+		Steps: []resource.TestStep{
+			// Must fail
+			{
+				Config:      testAccAttendancesInvalidPeriodDataSourceConfig,
+				ExpectError: regexp.MustCompile(`The end date 2024-08-01 must not be before the start date 2024-08-31`),
+			},
+
+			// Read testing
+			{
+				Config: testAccAttendancesDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.personio_attendances.test", "attendances.#", "2"),
+					resource.TestCheckResourceAttr("data.personio_attendances.test", "attendances.0.id", "81230001"),
+					resource.TestCheckResourceAttr("data.personio_attendances.test", "attendances.0.employee_id", employeeId),
+					resource.TestCheckResourceAttr("data.personio_attendances.test", "attendances.0.date", "2024-08-05"),
+					resource.TestCheckResourceAttr("data.personio_attendances.test", "attendances.0.start_time", "09:00"),
+					resource.TestCheckResourceAttr("data.personio_attendances.test", "attendances.0.end_time", "17:30"),
+					resource.TestCheckResourceAttr("data.personio_attendances.test", "attendances.0.break_minutes", "45"),
+					resource.TestCheckResourceAttr("data.personio_attendances.test", "attendances.0.comment", "Customer workshop"),
+					resource.TestCheckResourceAttr("data.personio_attendances.test", "attendances.0.project_id", "5301"),
+					resource.TestCheckResourceAttr("data.personio_attendances.test", "attendances.0.status", "confirmed"),
+					resource.TestCheckResourceAttr("data.personio_attendances.test", "attendances.0.updated_at", "2024-08-05T15:35:12Z"),
+					resource.TestCheckNoResourceAttr("data.personio_attendances.test", "attendances.1.end_time"),
+					resource.TestCheckNoResourceAttr("data.personio_attendances.test", "attendances.1.comment"),
+					resource.TestCheckNoResourceAttr("data.personio_attendances.test", "attendances.1.project_id"),
+					resource.TestCheckResourceAttr("data.personio_attendances.test", "attendances.1.status", "pending"),
+					testCheckQueryParameterSent(c, "company/attendances", "start_date", "2024-08-01"),
+					testCheckQueryParameterSent(c, "company/attendances", "end_date", "2024-08-31"),
+					testCheckQueryParameterSent(c, "company/attendances", "limit", "100"),
+				),
+			},
+			{
+				Config: testAccAttendancesFilteredDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testCheckQueryParameterSent(c, "company/attendances", "employees[]", employeeId),
+					testCheckQueryParameterSent(c, "company/attendances", "includePending", "true"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,113 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/nicoangelo/terraform-provider-personio/internal/adapter"
+	"github.com/nicoangelo/terraform-provider-personio/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var (
+	_ datasource.DataSource = &CostCentersDataSource{}
+)
+
+func NewCostCentersDataSource() datasource.DataSource {
+	return &CostCentersDataSource{}
+}
+
+// CostCentersDataSource defines the data source implementation.
+type CostCentersDataSource struct {
+	client *adapter.PersonioAdapter
+}
+
+// CostCentersDataSourceModel describes the data source data model.
+type CostCentersDataSourceModel struct {
+	CostCenters []adapter.CostCenterSummary `tfsdk:"cost_centers"`
+	Status      []types.String              `tfsdk:"status"`
+	Id          types.String                `tfsdk:"id"`
+}
+
+func (d *CostCentersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cost_centers"
+}
+
+func (d *CostCentersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: `
+Cost centers data source
+
+Retrieves the cost centers with the employees assigned to them and their share of the costs.
+The Personio API has no endpoint for cost centers, so they are derived from the cost centers of each employee:
+cost centers of the tenant without employees are not returned, and the cost center attribute must be readable by the API credential.
+An employee that lists a cost center several times is counted once, with the sum of the percentages.
+
+The cost centers are ordered by ID.
+`,
+		Attributes: map[string]schema.Attribute{
+			"cost_centers": schema.ListNestedAttribute{
+				MarkdownDescription: "List of cost centers.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: costCenterSummaryAttributes,
+				},
+			},
+			"status": employeeStatusFilter("Only count employees with one of these statuses"),
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier derived from a hash of the arguments and the returned cost centers. It only changes when either of them changes.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *CostCentersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*adapter.PersonioAdapter)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *adapter.PersonioAdapter, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *CostCentersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CostCentersDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var filter adapter.EmployeeFilter
+	for _, s := range data.Status {
+		filter.Statuses = append(filter.Statuses, s.ValueString())
+	}
+
+	costCenters, err := d.client.GetCostCenters(ctx, filter)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read cost centers, got error: %s", err))
+		return
+	}
+
+	data.CostCenters = costCenters
+	data.Id = utils.GetStableId("personio_cost_centers", data)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/jesse0michael/go-rest-assured/assured"
+)
+
+const testAccCostCentersDataSourceConfig = `
+data "personio_cost_centers" "test" {
+}
+`
+
+func TestAccCostCentersDataSource(t *testing.T) {
+	emps, _ := os.ReadFile("../../test/data/all_employees.json")
+	c := DefaultRestServerWith(assured.Call{
+		Path:       "/company/employees",
+		Method:     "GET",
+		StatusCode: 200,
+		Response:   emps,
+	})
+	defer c.Close()
+	t.Setenv("PERSONIO_API_URL", c.URL())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccCostCentersDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.personio_cost_centers.test", "cost_centers.#", "2"),
+					resource.TestCheckResourceAttr("data.personio_cost_centers.test", "cost_centers.0.id", "773240"),
+					resource.TestCheckResourceAttr("data.personio_cost_centers.test", "cost_centers.0.name", "Cost center 1"),
+					resource.TestCheckResourceAttr("data.personio_cost_centers.test", "cost_centers.0.headcount", "32"),
+					resource.TestCheckResourceAttr("data.personio_cost_centers.test", "cost_centers.0.allocations.#", "32"),
+					resource.TestCheckResourceAttr("data.personio_cost_centers.test", "cost_centers.1.id", "773241"),
+					resource.TestCheckResourceAttr("data.personio_cost_centers.test", "cost_centers.1.headcount", "2"),
+					resource.TestCheckResourceAttr("data.personio_cost_centers.test", "cost_centers.1.employee_ids.0", "13649281"),
+					resource.TestCheckResourceAttr("data.personio_cost_centers.test", "cost_centers.1.allocations.0.percentage", "100"),
+					resource.TestCheckResourceAttr("data.personio_cost_centers.test", "cost_centers.1.allocations.1.employee_id", "13649293"),
+					resource.TestCheckResourceAttr("data.personio_cost_centers.test", "cost_centers.1.allocations.1.percentage", "40"),
+				),
+			},
+		},
+	})
+}
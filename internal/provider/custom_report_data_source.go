@@ -0,0 +1,124 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/nicoangelo/terraform-provider-personio/internal/adapter"
+	"github.com/nicoangelo/terraform-provider-personio/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var (
+	_ datasource.DataSource = &CustomReportDataSource{}
+)
+
+func NewCustomReportDataSource() datasource.DataSource {
+	return &CustomReportDataSource{}
+}
+
+// CustomReportDataSource defines the data source implementation.
+type CustomReportDataSource struct {
+	client *adapter.PersonioAdapter
+}
+
+// CustomReportDataSourceModel describes the data source data model.
+type CustomReportDataSourceModel struct {
+	ReportId types.String                 `tfsdk:"report_id"`
+	Name     types.String                 `tfsdk:"name"`
+	Columns  []adapter.CustomReportColumn `tfsdk:"columns"`
+	Rows     []map[string]types.String    `tfsdk:"rows"`
+	Id       types.String                 `tfsdk:"id"`
+}
+
+func (d *CustomReportDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_custom_report"
+}
+
+func (d *CustomReportDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: `
+Custom report data source
+
+Retrieves the columns and rows of a custom report, so that the dataset can be curated in Personio
+and consumed in Terraform. Each row maps the column IDs to the cell values. The values are converted
+to strings the same way as ` + "`dynamic_attributes`" + ` of employees, according to the type of their column.
+`,
+		Attributes: map[string]schema.Attribute{
+			"report_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the custom report, as shown in the URL of the report in Personio.",
+				Required:            true,
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the custom report.",
+				Computed:            true,
+			},
+			"columns": schema.ListNestedAttribute{
+				MarkdownDescription: "Columns of the report, in the order of the report.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: customReportColumnAttributes,
+				},
+			},
+			"rows": schema.ListAttribute{
+				MarkdownDescription: "Rows of the report. Each row is a map from the column IDs to the cell values. Empty cells are `null`.",
+				ElementType:         types.MapType{ElemType: types.StringType},
+				Computed:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier derived from a hash of the arguments and the returned report. It only changes when either of them changes.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *CustomReportDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*adapter.PersonioAdapter)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *adapter.PersonioAdapter, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *CustomReportDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CustomReportDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	report, err := d.client.GetCustomReport(ctx, data.ReportId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read custom report %s, got error: %s", data.ReportId.ValueString(), err))
+		return
+	}
+
+	data.Name = report.Name
+	data.Columns = report.Columns
+	data.Rows = report.Rows
+	data.Id = utils.GetStableId("personio_custom_report", data)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,75 @@
+package provider
+
+import (
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/jesse0michael/go-rest-assured/assured"
+)
+
+const (
+	testAccCustomReportDataSourceConfig = `
+data "personio_custom_report" "test" {
+	report_id = "c8a5e3f0-7d1b-4b52-9a6e-2f4d8b1c0e93"
+}
+`
+	testAccCustomReportNotFoundDataSourceConfig = `
+data "personio_custom_report" "test" {
+	report_id = "unknown"
+}
+`
+)
+
+func TestAccCustomReportDataSource(t *testing.T) {
+	report, _ := os.ReadFile("../../test/data/custom_report.json")
+	c := DefaultRestServerWith(assured.Call{
+		Path:       "/company/custom-reports/reports/c8a5e3f0-7d1b-4b52-9a6e-2f4d8b1c0e93",
+		Method:     "GET",
+		StatusCode: 200,
+		Response:   report,
+	}, assured.Call{
+		Path:       "/company/custom-reports/reports/unknown",
+		Method:     "GET",
+		StatusCode: 404,
+		Response:   []byte(`{"success": false, "error": {"code": 0, "message": "The report was not found"}}`),
+	})
+	defer c.Close()
+	t.Setenv("PERSONIO_API_URL", c.URL())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+
+		Steps: []resource.TestStep{
+			// Must fail
+			{
+				Config:      testAccCustomReportNotFoundDataSourceConfig,
+				ExpectError: regexp.MustCompile(`Unable to read custom report unknown`),
+			},
+
+			// Read testing
+			{
+				Config: testAccCustomReportDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.personio_custom_report.test", "name", "Engineering roster"),
+					resource.TestCheckResourceAttr("data.personio_custom_report.test", "columns.#", "4"),
+					resource.TestCheckResourceAttr("data.personio_custom_report.test", "columns.1.id", "hire_date"),
+					resource.TestCheckResourceAttr("data.personio_custom_report.test", "columns.1.label", "Hire date"),
+					resource.TestCheckResourceAttr("data.personio_custom_report.test", "columns.1.type", "date"),
+					resource.TestCheckResourceAttr("data.personio_custom_report.test", "rows.#", "2"),
+					resource.TestCheckResourceAttr("data.personio_custom_report.test", "rows.0.email", "na@example.com"),
+					resource.TestCheckResourceAttr("data.personio_custom_report.test", "rows.0.hire_date", "2021-12-31T23:00:00Z"),
+					resource.TestCheckResourceAttr("data.personio_custom_report.test", "rows.0.dynamic_7124045", "3"),
+					resource.TestCheckResourceAttr("data.personio_custom_report.test", "rows.0.weekly_working_hours", "37.5"),
+					resource.TestCheckResourceAttr("data.personio_custom_report.test", "rows.1.email", "jd@example.com"),
+					resource.TestCheckNoResourceAttr("data.personio_custom_report.test", "rows.1.hire_date"),
+					resource.TestCheckNoResourceAttr("data.personio_custom_report.test", "rows.1.dynamic_7124045"),
+					resource.TestCheckResourceAttr("data.personio_custom_report.test", "rows.1.weekly_working_hours", "40"),
+					testCheckQueryParameterSent(c, "company/custom-reports/reports/c8a5e3f0-7d1b-4b52-9a6e-2f4d8b1c0e93", "page", "1"),
+				),
+			},
+		},
+	})
+}
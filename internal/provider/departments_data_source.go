@@ -0,0 +1,112 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/nicoangelo/terraform-provider-personio/internal/adapter"
+	"github.com/nicoangelo/terraform-provider-personio/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var (
+	_ datasource.DataSource = &DepartmentsDataSource{}
+)
+
+func NewDepartmentsDataSource() datasource.DataSource {
+	return &DepartmentsDataSource{}
+}
+
+// DepartmentsDataSource defines the data source implementation.
+type DepartmentsDataSource struct {
+	client *adapter.PersonioAdapter
+}
+
+// DepartmentsDataSourceModel describes the data source data model.
+type DepartmentsDataSourceModel struct {
+	Departments []adapter.Department `tfsdk:"departments"`
+	Status      []types.String       `tfsdk:"status"`
+	Id          types.String         `tfsdk:"id"`
+}
+
+func (d *DepartmentsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_departments"
+}
+
+func (d *DepartmentsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: `
+Departments data source
+
+Retrieves all departments with their employees. The Personio API has no endpoint for departments,
+so they are derived from the department of each employee: departments without employees are not returned,
+and the department attribute must be readable by the API credential.
+
+The departments are ordered by ID.
+`,
+		Attributes: map[string]schema.Attribute{
+			"departments": schema.ListNestedAttribute{
+				MarkdownDescription: "List of departments.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: departmentAttributes,
+				},
+			},
+			"status": employeeStatusFilter("Only count employees with one of these statuses"),
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier derived from a hash of the arguments and the returned departments. It only changes when either of them changes.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *DepartmentsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*adapter.PersonioAdapter)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *adapter.PersonioAdapter, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *DepartmentsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DepartmentsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var filter adapter.EmployeeFilter
+	for _, s := range data.Status {
+		filter.Statuses = append(filter.Statuses, s.ValueString())
+	}
+
+	departments, err := d.client.GetDepartments(ctx, filter)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read departments, got error: %s", err))
+		return
+	}
+
+	data.Departments = departments
+	data.Id = utils.GetStableId("personio_departments", data)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
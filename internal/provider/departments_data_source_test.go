@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/jesse0michael/go-rest-assured/assured"
+)
+
+const (
+	testAccDepartmentsDataSourceConfig = `
+data "personio_departments" "test" {
+}
+`
+	testAccDepartmentsActiveDataSourceConfig = `
+data "personio_departments" "test" {
+	status = ["active"]
+}
+`
+)
+
+func TestAccDepartmentsDataSource(t *testing.T) {
+	emps, _ := os.ReadFile("../../test/data/all_employees.json")
+	c := DefaultRestServerWith(assured.Call{
+		Path:       "/company/employees",
+		Method:     "GET",
+		StatusCode: 200,
+		Response:   emps,
+	})
+	defer c.Close()
+	t.Setenv("PERSONIO_API_URL", c.URL())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccDepartmentsDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.personio_departments.test", "departments.#", "6"),
+					resource.TestCheckResourceAttr("data.personio_departments.test", "departments.0.id", "4090747"),
+					resource.TestCheckResourceAttr("data.personio_departments.test", "departments.0.name", "IT"),
+					resource.TestCheckResourceAttr("data.personio_departments.test", "departments.0.headcount", "8"),
+					resource.TestCheckResourceAttr("data.personio_departments.test", "departments.0.employee_ids.#", "8"),
+					resource.TestCheckResourceAttr("data.personio_departments.test", "departments.0.employee_ids.0", "13649264"),
+					resource.TestCheckResourceAttr("data.personio_departments.test", "departments.0.employee_ids.7", employeeId),
+					resource.TestCheckResourceAttr("data.personio_departments.test", "departments.2.name", "Management"),
+					resource.TestCheckResourceAttr("data.personio_departments.test", "departments.2.headcount", "1"),
+					resource.TestCheckResourceAttr("data.personio_departments.test", "departments.4.name", "Marketing and Sales"),
+					resource.TestCheckResourceAttr("data.personio_departments.test", "departments.4.headcount", "11"),
+				),
+			},
+			{
+				Config: testAccDepartmentsActiveDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.personio_departments.test", "departments.#", "6"),
+					resource.TestCheckResourceAttr("data.personio_departments.test", "departments.4.headcount", "10"),
+					resource.TestCheckResourceAttr("data.personio_departments.test", "departments.4.employee_ids.#", "10"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,145 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/nicoangelo/terraform-provider-personio/internal/adapter"
+	"github.com/nicoangelo/terraform-provider-personio/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var (
+	_ datasource.DataSource = &DocumentCategoriesDataSource{}
+)
+
+func NewDocumentCategoriesDataSource() datasource.DataSource {
+	return &DocumentCategoriesDataSource{}
+}
+
+// DocumentCategoriesDataSource defines the data source implementation.
+type DocumentCategoriesDataSource struct {
+	client *adapter.PersonioAdapter
+}
+
+// DocumentCategoriesDataSourceModel describes the data source data model.
+type DocumentCategoriesDataSourceModel struct {
+	Categories []adapter.DocumentCategory `tfsdk:"categories"`
+	Name       types.String               `tfsdk:"name"`
+	CategoryId types.Int64                `tfsdk:"category_id"`
+	Id         types.String               `tfsdk:"id"`
+}
+
+func (d *DocumentCategoriesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_document_categories"
+}
+
+func (d *DocumentCategoriesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: `
+Document categories data source
+
+Retrieves the categories that employee documents can be filed under. If a ` + "`name`" + ` is given, the
+category with that name is looked up and its ID is exposed as ` + "`category_id`" + `. The lookup fails if no
+or more than one category has the name.
+`,
+		Attributes: map[string]schema.Attribute{
+			"categories": schema.ListNestedAttribute{
+				MarkdownDescription: "List of document categories. Only contains the matching category if `name` is set.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: documentCategoryAttributes,
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Look up the category with this name. The name must match exactly.",
+				Optional:            true,
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"category_id": schema.Int64Attribute{
+				MarkdownDescription: "ID of the category with the given `name`. Null if `name` is not set.",
+				Computed:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier derived from a hash of the arguments and the returned categories. It only changes when either of them changes.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *DocumentCategoriesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*adapter.PersonioAdapter)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *adapter.PersonioAdapter, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *DocumentCategoriesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DocumentCategoriesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	categories, err := d.client.GetDocumentCategories(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read document categories, got error: %s", err))
+		return
+	}
+
+	data.CategoryId = types.Int64Null()
+	if data.Name.IsNull() {
+		data.Categories = categories
+	} else {
+		name := data.Name.ValueString()
+		for _, c := range categories {
+			if c.Name.ValueString() == name {
+				data.Categories = append(data.Categories, c)
+			}
+		}
+		switch len(data.Categories) {
+		case 0:
+			resp.Diagnostics.AddAttributeError(path.Root("name"), "Document Category Not Found",
+				fmt.Sprintf("No document category found with name %q.", name))
+			return
+		case 1:
+			data.CategoryId = data.Categories[0].Id
+		default:
+			ids := make([]string, 0, len(data.Categories))
+			for _, c := range data.Categories {
+				ids = append(ids, c.Id.String())
+			}
+			resp.Diagnostics.AddAttributeError(path.Root("name"), "Multiple Document Categories Found",
+				fmt.Sprintf("%d document categories found with name %q (IDs %s). The lookup must match exactly one category.",
+					len(data.Categories), name, strings.Join(ids, ", ")))
+			return
+		}
+	}
+	data.Id = utils.GetStableId("personio_document_categories", data)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
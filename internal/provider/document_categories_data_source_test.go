@@ -0,0 +1,80 @@
+package provider
+
+import (
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/jesse0michael/go-rest-assured/assured"
+)
+
+const (
+	testAccDocumentCategoriesDataSourceConfig = `
+data "personio_document_categories" "test" {
+}
+`
+	testAccDocumentCategoriesByNameDataSourceConfig = `
+data "personio_document_categories" "test" {
+	name = "Payslips"
+}
+`
+	testAccDocumentCategoriesAmbiguousDataSourceConfig = `
+data "personio_document_categories" "test" {
+	name = "Certificates"
+}
+`
+	testAccDocumentCategoriesUnknownDataSourceConfig = `
+data "personio_document_categories" "test" {
+	name = "Invoices"
+}
+`
+)
+
+func TestAccDocumentCategoriesDataSource(t *testing.T) {
+	categories, _ := os.ReadFile("../../test/data/document_categories.json")
+	c := DefaultRestServerWith(assured.Call{
+		Path:       "/company/document-categories",
+		Method:     "GET",
+		StatusCode: 200,
+		Response:   categories,
+	})
+	defer c.Close()
+	t.Setenv("PERSONIO_API_URL", c.URL())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+
+		Steps: []resource.TestStep{
+			// Must fail
+			{
+				Config:      testAccDocumentCategoriesAmbiguousDataSourceConfig,
+				ExpectError: regexp.MustCompile(`2 document categories found with name "Certificates" \(IDs 112235, 112236\)`),
+			},
+			{
+				Config:      testAccDocumentCategoriesUnknownDataSourceConfig,
+				ExpectError: regexp.MustCompile(`No document category found with name "Invoices"`),
+			},
+
+			// Read testing
+			{
+				Config: testAccDocumentCategoriesDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.personio_document_categories.test", "categories.#", "4"),
+					resource.TestCheckResourceAttr("data.personio_document_categories.test", "categories.0.id", "112233"),
+					resource.TestCheckResourceAttr("data.personio_document_categories.test", "categories.0.name", "Contracts"),
+					resource.TestCheckNoResourceAttr("data.personio_document_categories.test", "category_id"),
+				),
+			},
+			{
+				Config: testAccDocumentCategoriesByNameDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.personio_document_categories.test", "categories.#", "1"),
+					resource.TestCheckResourceAttr("data.personio_document_categories.test", "categories.0.name", "Payslips"),
+					resource.TestCheckResourceAttr("data.personio_document_categories.test", "category_id", "112234"),
+				),
+			},
+		},
+	})
+}
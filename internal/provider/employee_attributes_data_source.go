@@ -0,0 +1,105 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/nicoangelo/terraform-provider-personio/internal/adapter"
+	"github.com/nicoangelo/terraform-provider-personio/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var (
+	_ datasource.DataSource = &EmployeeAttributesDataSource{}
+)
+
+func NewEmployeeAttributesDataSource() datasource.DataSource {
+	return &EmployeeAttributesDataSource{}
+}
+
+// EmployeeAttributesDataSource defines the data source implementation.
+type EmployeeAttributesDataSource struct {
+	client *adapter.PersonioAdapter
+}
+
+// EmployeeAttributesDataSourceModel describes the data source data model.
+type EmployeeAttributesDataSourceModel struct {
+	Attributes []adapter.EmployeeAttribute `tfsdk:"attributes"`
+	Id         types.String                `tfsdk:"id"`
+}
+
+func (d *EmployeeAttributesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_employee_attributes"
+}
+
+func (d *EmployeeAttributesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: `
+Employee attributes data source
+
+Retrieves the catalog of employee attributes that are defined in the Personio tenant. Only attributes
+that are configured as readable for the API credential are returned ("Readable employee attributes").
+
+Use this data source to look up the key of a dynamic attribute (e.g. ` + "`dynamic_123456`" + `) by its label,
+instead of hardcoding keys that differ between tenants.
+`,
+		Attributes: map[string]schema.Attribute{
+			"attributes": schema.ListNestedAttribute{
+				MarkdownDescription: "List of employee attributes.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: employeeAttributeAttributes,
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier derived from a hash of the arguments and the returned attributes. It only changes when either of them changes.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *EmployeeAttributesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*adapter.PersonioAdapter)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *adapter.PersonioAdapter, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *EmployeeAttributesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data EmployeeAttributesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	attributes, err := d.client.GetEmployeeAttributes(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read employee attributes, got error: %s", err))
+		return
+	}
+
+	data.Attributes = attributes
+	data.Id = utils.GetStableId("personio_employee_attributes", data)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,57 @@
+package provider
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/jesse0michael/go-rest-assured/assured"
+)
+
+const testAccEmployeeAttributesDataSourceConfig = `
+data "personio_employee_attributes" "test" {
+}
+`
+
+func TestAccEmployeeAttributesDataSource(t *testing.T) {
+	attrs, _ := os.ReadFile("../../test/data/employee_attributes.json")
+	c := DefaultRestServerWith(assured.Call{
+		Path:       "/company/employees/attributes",
+		Method:     "GET",
+		StatusCode: 200,
+		Response:   attrs,
+	})
+	defer c.Close()
+	t.Setenv("PERSONIO_API_URL", c.URL())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccEmployeeAttributesDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.personio_employee_attributes.test", "attributes.#", "60"),
+					resource.TestCheckResourceAttr("data.personio_employee_attributes.test", "attributes.1.key", "first_name"),
+					resource.TestCheckResourceAttr("data.personio_employee_attributes.test", "attributes.1.universal_id", "first_name"),
+					resource.TestCheckResourceAttr("data.personio_employee_attributes.test", "attributes.1.label", "First name"),
+					resource.TestCheckResourceAttr("data.personio_employee_attributes.test", "attributes.1.type", "standard"),
+					resource.TestCheckNoResourceAttr("data.personio_employee_attributes.test", "attributes.1.options"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.personio_employee_attributes.test", "attributes.*", map[string]string{
+						"key":       "dynamic_7124043",
+						"label":     "Trainings",
+						"type":      "tags",
+						"options.#": "3",
+						"options.1": "Fire safety",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("data.personio_employee_attributes.test", "attributes.*", map[string]string{
+						"key":   "dynamic_7124008",
+						"label": "Emergency contact phone number",
+					}),
+				),
+			},
+		},
+	})
+}
@@ -3,17 +3,23 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/nicoangelo/terraform-provider-personio/internal/adapter"
 	"github.com/nicoangelo/terraform-provider-personio/internal/formatter"
+	"github.com/nicoangelo/terraform-provider-personio/internal/utils"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces
 var (
-	_ datasource.DataSource = &EmployeeDataSource{}
+	_ datasource.DataSource                     = &EmployeeDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &EmployeeDataSource{}
 )
 
 func NewEmployeeDataSource() datasource.DataSource {
@@ -27,9 +33,18 @@ type EmployeeDataSource struct {
 
 // EmployeeDataSourceModel describes the data source data model.
 type EmployeeDataSourceModel struct {
-	Employee *adapter.Employee           `tfsdk:"employee"`
-	Id       types.Number                `tfsdk:"id"`
-	Formats  []formatter.FormatterConfig `tfsdk:"format"`
+	Employee              *adapter.Employee           `tfsdk:"employee"`
+	Id                    types.Int64                 `tfsdk:"id"`
+	Email                 types.String                `tfsdk:"email"`
+	Match                 *EmployeeMatchConfig        `tfsdk:"match"`
+	Formats               []formatter.FormatterConfig `tfsdk:"format"`
+	ExpandSupervisorDepth types.Int64                 `tfsdk:"expand_supervisor_depth"`
+}
+
+// EmployeeMatchConfig describes the match block to look up an employee by attribute value.
+type EmployeeMatchConfig struct {
+	Attribute types.String `tfsdk:"attribute"`
+	Value     types.String `tfsdk:"value"`
 }
 
 func (d *EmployeeDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -42,7 +57,16 @@ func (d *EmployeeDataSource) Schema(ctx context.Context, req datasource.SchemaRe
 		MarkdownDescription: `
 Employee data source
 
-Retrieves one employee by Personio ID and their attributes. The set of attributes that have a non-null value
+Retrieves one employee and their attributes. The employee is looked up by exactly one of
+- ` + "`id`" + `: the Personio ID
+- ` + "`email`" + `: the email address, which is sent to the Personio API as a filter
+- ` + "`match`" + `: the value of a dynamic attribute, e.g. a tenant-specific "Employee ID"
+
+Lookups by email or attribute value fail if none or more than one employee matches.
+Only dynamic attributes can be matched: preset attributes such as ` + "`first_name`" + ` or ` + "`department`" + `
+are rejected, as are tag attributes and attributes that are not readable by the API credential.
+
+The set of attributes that have a non-null value
 is defined by the configuration of the API credential in Personio ("Readable employee attributes").
 
 Certain attributes are preset and are always returned by this data source. These attributes cannot be removed or changed
@@ -50,8 +74,8 @@ in the Personio Admin interface. If an attribute is not configured as a readable
 its value will be ` + "`null`" + `. See attributes described as "preset"
 [in the Personio documentation](https://support.personio.de/hc/en-us/articles/115002250165-Best-Practice-Sections-and-Attributes).
 
-Dynamic attributes can be configured per tenant, and may have different types. All of them are converted to a
-string representation in Terraform.
+Dynamic attributes can be configured per tenant, and may have different types. In ` + "`dynamic_attributes`" + `,
+all of them are converted to a string representation in Terraform.
 Currently supported Personio API data types with their conversions are
 - integer/decimal -> number
 - date -> RFC3339 formatted string in UTC timezone
@@ -61,11 +85,20 @@ Currently supported Personio API data types with their conversions are
 
 Tag attributes are converted to a list of strings.
 
+` + "`dynamic_attributes_typed`" + ` contains the same attributes, including tag attributes, but keeps their Personio type.
+Each attribute is an object with its ` + "`type`" + ` and the value in the field that matches the type:
+- integer/decimal -> ` + "`number_value`" + `
+- standard/multiline/link/list -> ` + "`string_value`" + `
+- date -> ` + "`string_value`" + ` (RFC3339 formatted string in the timezone given by Personio) and ` + "`date_value`" + ` (year, month and day of that string)
+- tags -> ` + "`set_value`" + `
+
 ## Limitations
 
-- All *dynamic* employee attributes are converted to strings. This is due to employee attributes being
-  different for each tenant. Dynamic attributes on map values are not supported out of the box by Terraform.
-- Time attributes are returned in UTC timezone.
+- All *dynamic* employee attributes in ` + "`dynamic_attributes`" + ` are converted to strings. This is due to employee
+  attributes being different for each tenant. Dynamic attributes on map values are not supported out of the box by Terraform.
+  Use ` + "`dynamic_attributes_typed`" + ` to keep the Personio types.
+- Preset date attributes and dates in ` + "`dynamic_attributes`" + ` are returned in UTC timezone, so their day may differ
+  from the day shown in Personio. Dates in ` + "`dynamic_attributes_typed`" + ` keep the timezone given by Personio.
 `,
 		Attributes: map[string]schema.Attribute{
 			"employee": schema.SingleNestedAttribute{
@@ -73,9 +106,24 @@ Tag attributes are converted to a list of strings.
 				Computed:            true,
 				Attributes:          employeeAttributes,
 			},
-			"id": employeeIdRequired,
+			"id": employeeIdLookup,
+			"email": schema.StringAttribute{
+				MarkdownDescription: "Email address of the employee to look up.",
+				Optional:            true,
+			},
+			"expand_supervisor_depth": expandSupervisorDepth(),
 		},
-		Blocks: blocks,
+		Blocks: utils.MergeMaps(blocks, employeeLookupBlocks),
+	}
+}
+
+func (d *EmployeeDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("email"),
+			path.MatchRoot("match"),
+		),
 	}
 }
 
@@ -107,20 +155,98 @@ func (d *EmployeeDataSource) Read(ctx context.Context, req datasource.ReadReques
 		return
 	}
 
-	id, _ := data.Id.ValueBigFloat().Int64()
+	var employee adapter.Employee
+	if !data.Id.IsNull() {
+		var err error
+		employee, err = d.client.GetEmployee(ctx, data.Id.ValueInt64())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read employee, got error: %s", err))
+			return
+		}
+	} else {
+		var filter adapter.EmployeeFilter
+		var criteria string
+		if data.Match != nil {
+			filter.DynamicAttributes = map[string]string{data.Match.Attribute.ValueString(): data.Match.Value.ValueString()}
+			criteria = fmt.Sprintf("attribute %q with value %q", data.Match.Attribute.ValueString(), data.Match.Value.ValueString())
+		} else {
+			filter.Email = data.Email.ValueString()
+			criteria = fmt.Sprintf("email %q", data.Email.ValueString())
+		}
+
+		employees, err := d.client.GetEmployees(ctx, filter)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read employees, got error: %s", err))
+			return
+		}
+		switch len(employees) {
+		case 0:
+			if data.Match != nil {
+				resp.Diagnostics.Append(d.checkMatchAttribute(ctx, data.Match.Attribute.ValueString())...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+			}
+			resp.Diagnostics.AddError("Employee Not Found", fmt.Sprintf("No employee found with %s.", criteria))
+			return
+		case 1:
+			employee = employees[0]
+		default:
+			ids := make([]string, len(employees))
+			for i, e := range employees {
+				ids[i] = e.Id.String()
+			}
+			resp.Diagnostics.AddError("Multiple Employees Found",
+				fmt.Sprintf("%d employees found with %s (IDs %s). The lookup must match exactly one employee.",
+					len(employees), criteria, strings.Join(ids, ", ")))
+			return
+		}
+	}
 
-	employee, err := d.client.GetEmployee(id)
+	fmts := &formatter.FormatterCollection{}
+	fmts.FromConfig(data.Formats)
+	employee.ApplyFormats(fmts)
+
+	employees := []adapter.Employee{employee}
+	skipped, err := d.client.ExpandSupervisors(ctx, employees, int(data.ExpandSupervisorDepth.ValueInt64()), fmts)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read employee, got error: %s", err))
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to expand supervisors, got error: %s", err))
 		return
 	}
+	for _, e := range skipped {
+		resp.Diagnostics.AddWarning("Supervisor Chain Incomplete",
+			fmt.Sprintf("The supervisor chain of employee %d ends at a supervisor refused by Personio: %s", e.EmployeeId, e.Err))
+	}
 
-	fmts := &formatter.FormatterCollection{}
-	fmts.FromConfig(data.Formats)
-	fmts.FormatAll(employee.DynamicAttributes)
+	// options are additional information, the employees are returned without them
+	err = d.client.AddAttributeOptions(ctx, employees)
+	if err != nil {
+		resp.Diagnostics.AddWarning("Attribute Options Unavailable", fmt.Sprintf("Unable to read employee attribute options, options are left null, got error: %s", err))
+	}
 
-	data.Employee = &employee
+	data.Employee = &employees[0]
+	data.Id = employee.Id
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
+
+// checkMatchAttribute explains a lookup by attribute value without result if
+// the attribute is not a dynamic attribute of any employee, e.g. because it
+// is a tag attribute or not readable by the API credential.
+func (d *EmployeeDataSource) checkMatchAttribute(ctx context.Context, keyOrLabel string) (diags diag.Diagnostics) {
+	employees, err := d.client.GetEmployees(ctx, adapter.EmployeeFilter{})
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read employees, got error: %s", err))
+		return diags
+	}
+	for _, e := range employees {
+		if _, ok := e.DynamicAttribute(keyOrLabel); ok {
+			return diags
+		}
+	}
+	diags.AddAttributeError(path.Root("match").AtName("attribute"), "Unknown Dynamic Attribute",
+		fmt.Sprintf("No employee has a dynamic attribute with key or label %q. Only dynamic attributes that are readable "+
+			"by the API credential can be matched, preset and tag attributes cannot.", keyOrLabel))
+	return diags
+}
@@ -36,6 +36,64 @@ data "personio_employee" "test_with_format" {
 			format = "INTERNATIONAL"
 		}
 	}
+}`
+	testAccEmployeeWithFormatByLabelDataSourceConfig = `
+data "personio_employee" "test_with_format" {
+	id = ` + employeeId + `
+	format {
+		attribute = "Emergency contact phone number"
+		phonenumber = {
+			default_region = "AT"
+			format = "RFC3966"
+		}
+	}
+}`
+	testAccEmployeeByEmailDataSourceConfig = `
+data "personio_employee" "test" {
+	email = "na@example.com"
+}`
+	testAccEmployeeByAttributeDataSourceConfig = `
+data "personio_employee" "test" {
+	match {
+		attribute = "Employee ID"
+		value     = "45"
+	}
+}`
+	testAccEmployeeNoMatchDataSourceConfig = `
+data "personio_employee" "test" {
+	match {
+		attribute = "dynamic_7124008"
+		value     = "+41000000000"
+	}
+}`
+	testAccEmployeeMultipleMatchesDataSourceConfig = `
+data "personio_employee" "test" {
+	match {
+		attribute = "dynamic_7124039"
+		value     = "permanent employment"
+	}
+}`
+	testAccEmployeePresetMatchDataSourceConfig = `
+data "personio_employee" "test" {
+	match {
+		attribute = "first_name"
+		value     = "Margaret"
+	}
+}`
+	testAccEmployeeTagMatchDataSourceConfig = `
+data "personio_employee" "test" {
+	match {
+		attribute = "Trainings"
+		value     = "Fire safety"
+	}
+}`
+	testAccEmployeeConflictingLookupDataSourceConfig = `
+data "personio_employee" "test" {
+	id    = ` + employeeId + `
+	email = "na@example.com"
+}`
+	testAccEmployeeMissingLookupDataSourceConfig = `
+data "personio_employee" "test" {
 }`
 	testAccEmployeeNonExistingDataSourceConfig = `
 data "personio_employee" "test" {
@@ -45,7 +103,7 @@ data "personio_employee" "test" {
 
 func TestAccEmployeeDataSource(t *testing.T) {
 	emp, _ := os.ReadFile("../../test/data/one_employee.json")
-	c := DefaultRestServerWith(assured.Call{
+	c := DefaultRestServerWith(employeeAttributesEndpoint(), assured.Call{
 		Path:       "/company/employees/" + employeeId,
 		Method:     "GET",
 		StatusCode: 200,
@@ -68,6 +126,45 @@ func TestAccEmployeeDataSource(t *testing.T) {
 				Config: testAccEmployeeDataSourceConfig,
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("data.personio_employee.test", "employee.id", employeeId),
+					resource.TestCheckResourceAttr("data.personio_employee.test", "employee.dynamic_attributes.dynamic_7124060", "44"),
+					resource.TestCheckResourceAttr("data.personio_employee.test", "employee.dynamic_attributes_typed.dynamic_7124060.type", "integer"),
+					resource.TestCheckResourceAttr("data.personio_employee.test", "employee.dynamic_attributes_typed.dynamic_7124060.number_value", "44"),
+					resource.TestCheckResourceAttr("data.personio_employee.test", "employee.dynamic_attributes_typed.dynamic_7124061.number_value", "72.5"),
+					resource.TestCheckResourceAttr("data.personio_employee.test", "employee.dynamic_attributes_typed.dynamic_7124050.string_value", "2025-03-31T00:00:00+02:00"),
+					resource.TestCheckResourceAttr("data.personio_employee.test", "employee.dynamic_attributes_typed.dynamic_7124050.date_value.year", "2025"),
+					resource.TestCheckResourceAttr("data.personio_employee.test", "employee.dynamic_attributes_typed.dynamic_7124050.date_value.month", "3"),
+					resource.TestCheckResourceAttr("data.personio_employee.test", "employee.dynamic_attributes_typed.dynamic_7124050.date_value.day", "31"),
+					resource.TestCheckResourceAttr("data.personio_employee.test", "employee.dynamic_attributes_typed.dynamic_7124043.set_value.#", "2"),
+					resource.TestCheckTypeSetElemAttr("data.personio_employee.test", "employee.dynamic_attributes_typed.dynamic_7124043.set_value.*", "Fire safety"),
+					resource.TestCheckResourceAttr("data.personio_employee.test", "employee.dynamic_attributes_typed.dynamic_7124043.options.#", "3"),
+					resource.TestCheckResourceAttr("data.personio_employee.test", "employee.dynamic_attributes_typed.dynamic_7124011.type", "list"),
+					resource.TestCheckResourceAttr("data.personio_employee.test", "employee.dynamic_attributes_typed.dynamic_7124011.options.#", "4"),
+					resource.TestCheckResourceAttr("data.personio_employee.test", "employee.dynamic_attributes_typed.dynamic_7124011.options.1", "Married"),
+					resource.TestCheckNoResourceAttr("data.personio_employee.test", "employee.dynamic_attributes_typed.dynamic_7124008.options"),
+					resource.TestCheckResourceAttr("data.personio_employee.test", "employee.dynamic_attributes_typed.dynamic_7124008.string_value", "+41446681800"),
+					resource.TestCheckNoResourceAttr("data.personio_employee.test", "employee.dynamic_attributes_typed.dynamic_7124008.number_value"),
+					resource.TestCheckResourceAttr("data.personio_employee.test", "employee.attribute_labels.dynamic_7124008", "Emergency contact phone number"),
+					resource.TestCheckResourceAttr("data.personio_employee.test", "employee.attribute_labels.first_name", "First name"),
+					resource.TestCheckResourceAttr("data.personio_employee.test", "employee.dynamic_attributes_by_label.Emergency contact phone number", "+41446681800"),
+					resource.TestCheckResourceAttr("data.personio_employee.test", "employee.date_of_birth", "1988-06-14T22:00:00Z"),
+					resource.TestCheckResourceAttr("data.personio_employee.test", "employee.profile_picture", "https://api.personio.de/v1/company/employees/13649297/profile-picture"),
+					resource.TestCheckResourceAttr("data.personio_employee.test", "employee.work_schedule.name", "Full-time, 40 hours without time tracking, (mon,tue,wed,thu,fri)"),
+					resource.TestCheckResourceAttr("data.personio_employee.test", "employee.work_schedule.id", "1067807102794692000"),
+					resource.TestCheckResourceAttr("data.personio_employee.test", "employee.work_schedule.monday", "08:00"),
+					resource.TestCheckResourceAttr("data.personio_employee.test", "employee.work_schedule.sunday", "00:00"),
+					resource.TestCheckNoResourceAttr("data.personio_employee.test", "employee.work_schedule.valid_from"),
+					resource.TestCheckResourceAttr("data.personio_employee.test", "employee.holiday_calendar.id", "189"),
+					resource.TestCheckResourceAttr("data.personio_employee.test", "employee.holiday_calendar.name", "United Kingdom public holidays"),
+					resource.TestCheckResourceAttr("data.personio_employee.test", "employee.holiday_calendar.country", "GB"),
+					resource.TestCheckNoResourceAttr("data.personio_employee.test", "employee.holiday_calendar.state"),
+					resource.TestCheckResourceAttr("data.personio_employee.test", "employee.absence_entitlement.#", "1"),
+					resource.TestCheckResourceAttr("data.personio_employee.test", "employee.absence_entitlement.0.id", "2179197"),
+					resource.TestCheckResourceAttr("data.personio_employee.test", "employee.absence_entitlement.0.category", "paid_vacation"),
+					resource.TestCheckResourceAttr("data.personio_employee.test", "employee.absence_entitlement.0.entitlement", "0"),
+					resource.TestCheckResourceAttr("data.personio_employee.test", "employee.cost_centers.#", "2"),
+					resource.TestCheckResourceAttr("data.personio_employee.test", "employee.cost_centers.0.name", "IT Operations"),
+					resource.TestCheckResourceAttr("data.personio_employee.test", "employee.cost_centers.1.id", "3052"),
+					resource.TestCheckResourceAttr("data.personio_employee.test", "employee.cost_centers.1.percentage", "40"),
 				),
 			},
 			{
@@ -80,6 +177,14 @@ func TestAccEmployeeDataSource(t *testing.T) {
 				Config: testAccEmployeeWithFormatInternationalDataSourceConfig,
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("data.personio_employee.test_with_format", "employee.dynamic_attributes.dynamic_7124008", "+41 44 668 18 00"),
+					resource.TestCheckResourceAttr("data.personio_employee.test_with_format", "employee.dynamic_attributes_typed.dynamic_7124008.string_value", "+41 44 668 18 00"),
+				),
+			},
+			{
+				Config: testAccEmployeeWithFormatByLabelDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.personio_employee.test_with_format", "employee.dynamic_attributes.dynamic_7124008", "tel:+41-44-668-18-00"),
+					resource.TestCheckResourceAttr("data.personio_employee.test_with_format", "employee.dynamic_attributes_by_label.Emergency contact phone number", "tel:+41-44-668-18-00"),
 				),
 			},
 
@@ -91,3 +196,216 @@ func TestAccEmployeeDataSource(t *testing.T) {
 		},
 	})
 }
+
+const testAccEmployeeFromEmployeesDataSourceConfig = `
+data "personio_employees" "all" {
+}
+
+data "personio_employee" "test" {
+	id = ` + employeeId + `
+
+	depends_on = [data.personio_employees.all]
+}`
+
+func TestAccEmployeeDataSourceFromCache(t *testing.T) {
+	// no stub for the single employee: it must be taken from the list of all employees
+	emps, _ := os.ReadFile("../../test/data/all_employees.json")
+	c := DefaultRestServerWith(employeeAttributesEndpoint(), assured.Call{
+		Path:       "/company/employees",
+		Method:     "GET",
+		StatusCode: 200,
+		Response:   emps,
+	})
+	defer c.Close()
+	t.Setenv("PERSONIO_API_URL", c.URL())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEmployeeFromEmployeesDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.personio_employee.test", "employee.id", employeeId),
+					resource.TestCheckResourceAttr("data.personio_employee.test", "employee.email", "na@example.com"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEmployeeDataSourceWithoutAttributeOptions(t *testing.T) {
+	// a failing attribute catalog must not fail the read, only leave the options null
+	emp, _ := os.ReadFile("../../test/data/one_employee.json")
+	c := DefaultRestServerWith(assured.Call{
+		Path:       "/company/employees/attributes",
+		Method:     "GET",
+		StatusCode: 400,
+	}, assured.Call{
+		Path:       "/company/employees/" + employeeId,
+		Method:     "GET",
+		StatusCode: 200,
+		Response:   emp,
+	})
+	defer c.Close()
+	t.Setenv("PERSONIO_API_URL", c.URL())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEmployeeDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.personio_employee.test", "employee.id", employeeId),
+					resource.TestCheckResourceAttr("data.personio_employee.test", "employee.dynamic_attributes_typed.dynamic_7124011.type", "list"),
+					resource.TestCheckNoResourceAttr("data.personio_employee.test", "employee.dynamic_attributes_typed.dynamic_7124011.options"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEmployeeDataSourceLookup(t *testing.T) {
+	emps, _ := os.ReadFile("../../test/data/all_employees.json")
+	c := DefaultRestServerWith(employeeAttributesEndpoint(), assured.Call{
+		Path:       "/company/employees",
+		Method:     "GET",
+		StatusCode: 200,
+		Response:   emps,
+	})
+	defer c.Close()
+	t.Setenv("PERSONIO_API_URL", c.URL())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+
+		Steps: []resource.TestStep{
+			// Must fail
+			{
+				Config:      testAccEmployeeNoMatchDataSourceConfig,
+				ExpectError: regexp.MustCompile(`No employee found with attribute "dynamic_7124008" with value\s+"\+41000000000"`),
+			},
+			{
+				Config:      testAccEmployeeMultipleMatchesDataSourceConfig,
+				ExpectError: regexp.MustCompile(`employees found with attribute "dynamic_7124039" with value\s+"permanent\s+employment"`),
+			},
+			{
+				Config:      testAccEmployeePresetMatchDataSourceConfig,
+				ExpectError: regexp.MustCompile(`value must be none of`),
+			},
+			{
+				Config:      testAccEmployeeTagMatchDataSourceConfig,
+				ExpectError: regexp.MustCompile(`No employee has a dynamic attribute with key or label "Trainings"`),
+			},
+			{
+				Config:      testAccEmployeeConflictingLookupDataSourceConfig,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			{
+				Config:      testAccEmployeeMissingLookupDataSourceConfig,
+				ExpectError: regexp.MustCompile(`Missing Attribute Configuration`),
+			},
+
+			// Must succeed
+			{
+				Config: testAccEmployeeByEmailDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.personio_employee.test", "id", employeeId),
+					resource.TestCheckResourceAttr("data.personio_employee.test", "employee.id", employeeId),
+					testCheckQueryParameterSent(c, "company/employees", "email", "na@example.com"),
+				),
+			},
+			{
+				Config: testAccEmployeeByAttributeDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.personio_employee.test", "id", "13649293"),
+					resource.TestCheckResourceAttr("data.personio_employee.test", "employee.email", "margaret.martinez@demo-sample.com"),
+					resource.TestCheckResourceAttr("data.personio_employee.test", "employee.profile.office", "London"),
+					resource.TestCheckResourceAttr("data.personio_employee.test", "employee.profile.office_id", "1559799"),
+				),
+			},
+		},
+	})
+}
+
+const testAccEmployeeExpandSupervisorsDataSourceConfig = `
+data "personio_employee" "test" {
+	email = "margaret.martinez@demo-sample.com"
+
+	expand_supervisor_depth = 1
+}
+`
+
+func TestAccEmployeeDataSourceExpandSupervisors(t *testing.T) {
+	emps, _ := os.ReadFile("../../test/data/all_employees.json")
+	supervisor, _ := os.ReadFile("../../test/data/supervisor_employee.json")
+	c := DefaultRestServerWith(employeeAttributesEndpoint(), assured.Call{
+		Path:       "/company/employees",
+		Method:     "GET",
+		StatusCode: 200,
+		Response:   emps,
+	}, assured.Call{
+		Path:       "/company/employees/13649274",
+		Method:     "GET",
+		StatusCode: 200,
+		Response:   supervisor,
+	}, assured.Call{
+		// terminated supervisor of the supervisor
+		Path:       "/company/employees/13649262",
+		Method:     "GET",
+		StatusCode: 404,
+	})
+	defer c.Close()
+	t.Setenv("PERSONIO_API_URL", c.URL())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+
+		Steps: []resource.TestStep{
+			// Must fail
+			{
+				Config: `
+data "personio_employee" "test" {
+	id                      = 13649293
+	expand_supervisor_depth = 0
+}`,
+				ExpectError: regexp.MustCompile(`expand_supervisor_depth value must be at least 1`),
+			},
+
+			// Must succeed
+			{
+				Config: testAccEmployeeByEmailDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("data.personio_employee.test", "employee.supervisor_chain.#"),
+				),
+			},
+			{
+				Config: testAccEmployeeExpandSupervisorsDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.personio_employee.test", "employee.id", "13649293"),
+					resource.TestCheckResourceAttr("data.personio_employee.test", "employee.supervisor_chain.#", "1"),
+					resource.TestCheckResourceAttr("data.personio_employee.test", "employee.supervisor_chain.0.id", "13649274"),
+					resource.TestCheckResourceAttr("data.personio_employee.test", "employee.supervisor_chain.0.email", "david.evans@demo-sample.com"),
+					resource.TestCheckResourceAttr("data.personio_employee.test", "employee.supervisor_chain.0.hr_info.position", "Teamlead Sales"),
+				),
+			},
+			{
+				Config: `
+data "personio_employee" "test" {
+	email = "margaret.martinez@demo-sample.com"
+
+	expand_supervisor_depth = 2
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.personio_employee.test", "employee.supervisor_chain.#", "1"),
+					resource.TestCheckResourceAttr("data.personio_employee.test", "employee.supervisor_chain.0.id", "13649274"),
+				),
+			},
+		},
+	})
+}
@@ -4,8 +4,13 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/nicoangelo/terraform-provider-personio/internal/adapter"
 	"github.com/nicoangelo/terraform-provider-personio/internal/formatter"
@@ -31,6 +36,23 @@ type EmployeesDataSourceModel struct {
 	Employees []adapter.Employee          `tfsdk:"employees"`
 	Id        types.String                `tfsdk:"id"`
 	Formats   []formatter.FormatterConfig `tfsdk:"format"`
+
+	Email                 types.String                   `tfsdk:"email"`
+	Status                []types.String                 `tfsdk:"status"`
+	DepartmentId          types.Int64                    `tfsdk:"department_id"`
+	TeamId                types.Int64                    `tfsdk:"team_id"`
+	Office                types.String                   `tfsdk:"office"`
+	UpdatedSince          types.String                   `tfsdk:"updated_since"`
+	DynamicAttributes     []DynamicAttributeFilterConfig `tfsdk:"dynamic_attribute"`
+	SortBy                types.String                   `tfsdk:"sort_by"`
+	Limit                 types.Int64                    `tfsdk:"limit"`
+	ExpandSupervisorDepth types.Int64                    `tfsdk:"expand_supervisor_depth"`
+}
+
+// DynamicAttributeFilterConfig describes a dynamic_attribute filter block.
+type DynamicAttributeFilterConfig struct {
+	Attribute types.String `tfsdk:"attribute"`
+	Value     types.String `tfsdk:"value"`
 }
 
 func (d *EmployeesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -46,6 +68,10 @@ Employees data source
 Retrieves all employees and their attributes. The set of attributes that have a non-null value
 is defined by the configuration of the API credential in Personio ("Readable employee attributes").
 
+The list of employees can be narrowed down with the optional filter arguments. All filters must match.
+` + "`email`" + ` and ` + "`updated_since`" + ` are sent to the Personio API, all other filters are applied by the provider.
+Filters are applied to the unformatted attribute values.
+
 For more information on limitations and output conversion, see [personio_employee data source](./employee).
 `,
 		Attributes: map[string]schema.Attribute{
@@ -57,11 +83,49 @@ For more information on limitations and output conversion, see [personio_employe
 				},
 			},
 			"id": schema.StringAttribute{
-				MarkdownDescription: "Identifier",
+				MarkdownDescription: "Identifier derived from a hash of the arguments and the returned employees. It only changes when either of them changes.",
 				Computed:            true,
 			},
+			"email": schema.StringAttribute{
+				MarkdownDescription: "Only return the employee with this email address.",
+				Optional:            true,
+			},
+			"status": employeeStatusFilter("Only return employees with one of these statuses"),
+			"department_id": schema.Int64Attribute{
+				MarkdownDescription: "Only return employees of the department with this ID.",
+				Optional:            true,
+			},
+			"team_id": schema.Int64Attribute{
+				MarkdownDescription: "Only return employees of the team with this ID.",
+				Optional:            true,
+			},
+			"office": schema.StringAttribute{
+				MarkdownDescription: "Only return employees of the office with this name.",
+				Optional:            true,
+			},
+			"updated_since": schema.StringAttribute{
+				MarkdownDescription: "Only return employees whose record was modified at or after this time. " +
+					"Accepts an RFC3339 timestamp (e.g. `2024-01-31T08:00:00Z`) or a date (e.g. `2024-01-31`), which is interpreted in UTC.",
+				Optional: true,
+			},
+			"sort_by": schema.StringAttribute{
+				MarkdownDescription: "Sort the employees in ascending order by this attribute (" + utils.QuoteJoin(adapter.EmployeeSortFields) + "). " +
+					"Employees with the same value are sorted by ID. If not set, the order of the Personio API is kept.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(adapter.EmployeeSortFields...),
+				},
+			},
+			"limit": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of employees to return, applied after filtering and sorting.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"expand_supervisor_depth": expandSupervisorDepth(),
 		},
-		Blocks: blocks,
+		Blocks: utils.MergeMaps(blocks, employeesFilterBlocks),
 	}
 }
 
@@ -93,7 +157,13 @@ func (d *EmployeesDataSource) Read(ctx context.Context, req datasource.ReadReque
 		return
 	}
 
-	employees, err := d.client.GetEmployees()
+	filter, diags := data.filter()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	employees, err := d.client.GetEmployees(ctx, filter)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read employees, got error: %s", err))
 		return
@@ -103,12 +173,61 @@ func (d *EmployeesDataSource) Read(ctx context.Context, req datasource.ReadReque
 	fmts.FromConfig(data.Formats)
 
 	for _, e := range employees {
-		fmts.FormatAll(e.DynamicAttributes)
+		e.ApplyFormats(fmts)
 		data.Employees = append(data.Employees, e)
 	}
 
-	data.Id = utils.GetUnstableId("personio_employees")
+	skipped, err := d.client.ExpandSupervisors(ctx, data.Employees, int(data.ExpandSupervisorDepth.ValueInt64()), fmts)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to expand supervisors, got error: %s", err))
+		return
+	}
+	for _, e := range skipped {
+		resp.Diagnostics.AddWarning("Supervisor Chain Incomplete",
+			fmt.Sprintf("The supervisor chain of employee %d ends at a supervisor refused by Personio: %s", e.EmployeeId, e.Err))
+	}
+
+	// options are additional information, the employees are returned without them
+	err = d.client.AddAttributeOptions(ctx, data.Employees)
+	if err != nil {
+		resp.Diagnostics.AddWarning("Attribute Options Unavailable", fmt.Sprintf("Unable to read employee attribute options, options are left null, got error: %s", err))
+	}
+
+	data.Id = utils.GetStableId("personio_employees", data)
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
+
+// filter converts the filter arguments to an adapter.EmployeeFilter.
+func (m EmployeesDataSourceModel) filter() (f adapter.EmployeeFilter, diags diag.Diagnostics) {
+	f.Email = m.Email.ValueString()
+	for _, s := range m.Status {
+		f.Statuses = append(f.Statuses, s.ValueString())
+	}
+	f.DepartmentId = m.DepartmentId.ValueInt64Pointer()
+	f.TeamId = m.TeamId.ValueInt64Pointer()
+	f.Office = m.Office.ValueString()
+	if !m.UpdatedSince.IsNull() {
+		t, err := utils.ParseTimeOrDate(m.UpdatedSince.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("updated_since"), "Invalid Attribute Value", err.Error())
+		}
+		f.UpdatedSince = &t
+	}
+	if len(m.DynamicAttributes) > 0 {
+		f.DynamicAttributes = map[string]string{}
+		for _, da := range m.DynamicAttributes {
+			name := da.Attribute.ValueString()
+			if _, ok := f.DynamicAttributes[name]; ok {
+				diags.AddAttributeError(path.Root("dynamic_attribute"), "Duplicate Dynamic Attribute Filter",
+					fmt.Sprintf("The attribute %q is filtered by more than one dynamic_attribute block. An attribute can only be compared to one value.", name))
+				continue
+			}
+			f.DynamicAttributes[name] = da.Value.ValueString()
+		}
+	}
+	f.SortBy = m.SortBy.ValueString()
+	f.Limit = int(m.Limit.ValueInt64())
+	return f, diags
+}
@@ -1,21 +1,85 @@
 package provider
 
 import (
+	"fmt"
 	"os"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/jesse0michael/go-rest-assured/assured"
 )
 
-const testAccEmployeesDataSourceConfig = `
+const (
+	testAccEmployeesDataSourceConfig = `
 data "personio_employees" "test" {
 }
 `
+	testAccEmployeesFilteredDataSourceConfig = `
+data "personio_employees" "team" {
+	team_id = 1786274
+	status  = ["active"]
+}
+
+data "personio_employees" "first_aiders" {
+	dynamic_attribute {
+		attribute = "First Aider"
+		value     = "yes"
+	}
+}
+
+data "personio_employees" "working_students" {
+	dynamic_attribute {
+		attribute = "dynamic_7124039"
+		value     = "working student"
+	}
+	office = "London"
+}
+
+data "personio_employees" "sorted" {
+	sort_by = "email"
+	limit   = 3
+}
+
+data "personio_employees" "updated" {
+	updated_since = "2023-03-01"
+}
+`
+	testAccEmployeesByEmailDataSourceConfig = `
+data "personio_employees" "test" {
+	email = "na@example.com"
+}
+`
+	testAccEmployeesTwiceDataSourceConfig = `
+data "personio_employees" "test" {
+}
+
+data "personio_employees" "other" {
+}
+`
+	testAccEmployeesDuplicateDynamicAttributeDataSourceConfig = `
+data "personio_employees" "test" {
+	dynamic_attribute {
+		attribute = "dynamic_7124039"
+		value     = "working student"
+	}
+	dynamic_attribute {
+		attribute = "dynamic_7124039"
+		value     = "intern"
+	}
+}
+`
+	testAccEmployeesInvalidUpdatedSinceDataSourceConfig = `
+data "personio_employees" "test" {
+	updated_since = "yesterday"
+}
+`
+)
 
 func TestAccEmployeesDataSource(t *testing.T) {
 	emps, _ := os.ReadFile("../../test/data/all_employees.json")
-	c := DefaultRestServerWith(assured.Call{
+	c := DefaultRestServerWith(employeeAttributesEndpoint(), assured.Call{
 		Path:       "/company/employees",
 		Method:     "GET",
 		StatusCode: 200,
@@ -36,6 +100,185 @@ func TestAccEmployeesDataSource(t *testing.T) {
 					resource.TestCheckResourceAttr("data.personio_employees.test", "employees.#", "34"),
 				),
 			},
+			{
+				Config: testAccEmployeesFilteredDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.personio_employees.team", "employees.#", "4"),
+					resource.TestCheckResourceAttr("data.personio_employees.first_aiders", "employees.#", "14"),
+					resource.TestCheckResourceAttr("data.personio_employees.working_students", "employees.#", "2"),
+					resource.TestCheckResourceAttr("data.personio_employees.sorted", "employees.#", "3"),
+					resource.TestCheckResourceAttr("data.personio_employees.sorted", "employees.0.email", "alan.foster@demo-sample.com"),
+					resource.TestCheckResourceAttr("data.personio_employees.sorted", "employees.1.email", "alena.jacobs@demo-sample.com"),
+					resource.TestCheckResourceAttr("data.personio_employees.sorted", "employees.2.email", "alfred.jones@demo-sample.com"),
+					resource.TestCheckResourceAttr("data.personio_employees.updated", "employees.#", "1"),
+					resource.TestCheckResourceAttr("data.personio_employees.updated", "employees.0.email", "na@example.com"),
+				),
+			},
+			{
+				Config: testAccEmployeesByEmailDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.personio_employees.test", "employees.#", "1"),
+					resource.TestCheckResourceAttr("data.personio_employees.test", "employees.0.id", employeeId),
+					testCheckQueryParameterSent(c, "company/employees", "email", "na@example.com"),
+				),
+			},
+
+			// Must fail
+			{
+				Config:      testAccEmployeesInvalidUpdatedSinceDataSourceConfig,
+				ExpectError: regexp.MustCompile(`"yesterday" is neither an RFC3339 timestamp nor a date`),
+			},
+			{
+				Config:      testAccEmployeesDuplicateDynamicAttributeDataSourceConfig,
+				ExpectError: regexp.MustCompile(`The attribute "dynamic_7124039" is filtered by more than one`),
+			},
+		},
+	})
+}
+
+func TestAccEmployeesDataSourceStableId(t *testing.T) {
+	emps, _ := os.ReadFile("../../test/data/all_employees.json")
+	c := DefaultRestServerWith(employeeAttributesEndpoint(), assured.Call{
+		Path:       "/company/employees",
+		Method:     "GET",
+		StatusCode: 200,
+		Response:   emps,
+	})
+	defer c.Close()
+	t.Setenv("PERSONIO_API_URL", c.URL())
+
+	var id, contentHash string
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEmployeesDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("data.personio_employees.test", "id", regexp.MustCompile(`^personio_employees-[0-9a-f]{64}$`)),
+					resource.TestMatchResourceAttr("data.personio_employees.test", "employees.0.content_hash", regexp.MustCompile(`^[0-9a-f]{64}$`)),
+					resource.TestCheckResourceAttrWith("data.personio_employees.test", "id", func(value string) error {
+						id = value
+						return nil
+					}),
+					resource.TestCheckResourceAttrWith("data.personio_employees.test", "employees.0.content_hash", func(value string) error {
+						contentHash = value
+						return nil
+					}),
+				),
+			},
+			// the same query and content yield the same id and hashes
+			{
+				Config: testAccEmployeesTwiceDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPtr("data.personio_employees.test", "id", &id),
+					resource.TestCheckResourceAttrPtr("data.personio_employees.other", "id", &id),
+					resource.TestCheckResourceAttrPtr("data.personio_employees.test", "employees.0.content_hash", &contentHash),
+				),
+			},
+			// a different query yields a different id, but the same hash for the same employee
+			{
+				Config: testAccEmployeesByEmailDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrWith("data.personio_employees.test", "id", func(value string) error {
+						if value == id {
+							return fmt.Errorf("expected id to differ from %s", id)
+						}
+						return nil
+					}),
+					resource.TestCheckResourceAttrPtr("data.personio_employees.test", "employees.0.content_hash", &contentHash),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEmployeesDataSourceLargeIds(t *testing.T) {
+	emps, _ := os.ReadFile("../../test/data/large_id_employees.json")
+	c := DefaultRestServerWith(employeeAttributesEndpoint(), assured.Call{
+		Path:       "/company/employees",
+		Method:     "GET",
+		StatusCode: 200,
+		Response:   emps,
+	})
+	defer c.Close()
+	t.Setenv("PERSONIO_API_URL", c.URL())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEmployeesDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.personio_employees.test", "employees.#", "2"),
+					resource.TestCheckResourceAttr("data.personio_employees.test", "employees.0.id", "9007199254740993"),
+					resource.TestCheckResourceAttr("data.personio_employees.test", "employees.1.id", "9007199254740995"),
+					resource.TestCheckResourceAttr("data.personio_employees.test", "employees.1.profile.supervisor.id", "9007199254740993"),
+					resource.TestCheckResourceAttr("data.personio_employees.test", "employees.1.profile.department_id", "9007199254740997"),
+					resource.TestCheckResourceAttr("data.personio_employees.test", "employees.1.work_schedule.id", "1067807102794692001"),
+					resource.TestCheckResourceAttr("data.personio_employees.test", "employees.1.dynamic_attributes.dynamic_7124060", "9007199254740999"),
+					resource.TestCheckResourceAttr("data.personio_employees.test", "employees.1.dynamic_attributes_typed.dynamic_7124060.number_value", "9007199254740999"),
+				),
+			},
+		},
+	})
+}
+
+const testAccEmployeesExpandSupervisorsDataSourceConfig = `
+data "personio_employees" "test" {
+	limit                   = 2
+	expand_supervisor_depth = 2
+}
+`
+
+func TestAccEmployeesDataSourceExpandSupervisors(t *testing.T) {
+	// no stubs for single employees: supervisors must be taken from the list of all employees
+	emps, _ := os.ReadFile("../../test/data/all_employees.json")
+	c := DefaultRestServerWith(employeeAttributesEndpoint(), assured.Call{
+		Path:       "/company/employees",
+		Method:     "GET",
+		StatusCode: 200,
+		Response:   emps,
+	})
+	defer c.Close()
+	t.Setenv("PERSONIO_API_URL", c.URL())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEmployeesExpandSupervisorsDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.personio_employees.test", "employees.#", "2"),
+					resource.TestCheckResourceAttr("data.personio_employees.test", "employees.0.supervisor_chain.#", "0"),
+					resource.TestCheckResourceAttr("data.personio_employees.test", "employees.1.id", "13649293"),
+					resource.TestCheckResourceAttr("data.personio_employees.test", "employees.1.supervisor_chain.#", "2"),
+					resource.TestCheckResourceAttr("data.personio_employees.test", "employees.1.supervisor_chain.0.id", "13649274"),
+					resource.TestCheckResourceAttr("data.personio_employees.test", "employees.1.supervisor_chain.0.status", "active"),
+					resource.TestCheckResourceAttr("data.personio_employees.test", "employees.1.supervisor_chain.0.hr_info.position", "Teamlead Sales"),
+					resource.TestCheckResourceAttr("data.personio_employees.test", "employees.1.supervisor_chain.0.profile.department", "Marketing and Sales"),
+					resource.TestCheckResourceAttr("data.personio_employees.test", "employees.1.supervisor_chain.0.profile.supervisor.id", "13649262"),
+					resource.TestCheckResourceAttr("data.personio_employees.test", "employees.1.supervisor_chain.1.id", "13649262"),
+					resource.TestCheckResourceAttr("data.personio_employees.test", "employees.1.supervisor_chain.1.email", "alfred.jones@demo-sample.com"),
+					func(s *terraform.State) error {
+						for _, id := range []string{"13649274", "13649262"} {
+							calls, err := c.Verify("GET", "company/employees/"+id)
+							if err != nil {
+								return err
+							}
+							if len(calls) > 0 {
+								return fmt.Errorf("expected supervisor %s to be taken from the list, got %d requests", id, len(calls))
+							}
+						}
+						return nil
+					},
+				),
+			},
 		},
 	})
 }
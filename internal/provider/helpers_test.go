@@ -2,21 +2,58 @@ package provider
 
 import (
 	"fmt"
+	"os"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/jesse0michael/go-rest-assured/assured"
 )
 
 func DefaultRestServerWith(endpoints ...assured.Call) *assured.Client {
 	c := assured.NewDefaultClient()
-	c.Given(assured.Call{
+	auth := assured.Call{
 		Path:       "/auth",
 		Method:     "POST",
 		StatusCode: 200,
 		Response:   []byte(`{"success": true, "data": { "token": "ghi" } }`),
-	})
+	}
+	// the mock server starts listening asynchronously
+	for i := 0; i < 50 && c.Given(auth) != nil; i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
 	for _, e := range endpoints {
 		c.Given(e)
 	}
 	fmt.Println("Rest assured running on", c.URL())
 	return c
 }
+
+// employeeAttributesEndpoint stubs the attribute catalog, which the employee
+// data sources read for the options of list and tags attributes.
+func employeeAttributesEndpoint() assured.Call {
+	attrs, _ := os.ReadFile("../../test/data/employee_attributes.json")
+	return assured.Call{
+		Path:       "/company/employees/attributes",
+		Method:     "GET",
+		StatusCode: 200,
+		Response:   attrs,
+	}
+}
+
+// testCheckQueryParameterSent verifies that at least one request to the mock server
+// at path carried the query parameter with the given value.
+func testCheckQueryParameterSent(c *assured.Client, path string, key string, value string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		calls, err := c.Verify("GET", path)
+		if err != nil {
+			return err
+		}
+		for _, call := range calls {
+			if call.Query[key] == value {
+				return nil
+			}
+		}
+		return fmt.Errorf("no request to %s with query parameter %s=%s", path, key, value)
+	}
+}
@@ -0,0 +1,112 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/nicoangelo/terraform-provider-personio/internal/adapter"
+	"github.com/nicoangelo/terraform-provider-personio/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var (
+	_ datasource.DataSource = &HolidayCalendarsDataSource{}
+)
+
+func NewHolidayCalendarsDataSource() datasource.DataSource {
+	return &HolidayCalendarsDataSource{}
+}
+
+// HolidayCalendarsDataSource defines the data source implementation.
+type HolidayCalendarsDataSource struct {
+	client *adapter.PersonioAdapter
+}
+
+// HolidayCalendarsDataSourceModel describes the data source data model.
+type HolidayCalendarsDataSourceModel struct {
+	HolidayCalendars []adapter.HolidayCalendarSummary `tfsdk:"holiday_calendars"`
+	Status           []types.String                   `tfsdk:"status"`
+	Id               types.String                     `tfsdk:"id"`
+}
+
+func (d *HolidayCalendarsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_holiday_calendars"
+}
+
+func (d *HolidayCalendarsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: `
+Holiday calendars data source
+
+Retrieves all holiday calendars with their country, state and employees. The Personio API has no endpoint for
+holiday calendars, so they are derived from the holiday calendar of each employee: holiday calendars without
+employees are not returned, and the holiday calendar attribute must be readable by the API credential.
+
+The holiday calendars are ordered by ID.
+`,
+		Attributes: map[string]schema.Attribute{
+			"holiday_calendars": schema.ListNestedAttribute{
+				MarkdownDescription: "List of holiday calendars.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: holidayCalendarSummaryAttributes,
+				},
+			},
+			"status": employeeStatusFilter("Only count employees with one of these statuses"),
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier derived from a hash of the arguments and the returned holiday calendars. It only changes when either of them changes.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *HolidayCalendarsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*adapter.PersonioAdapter)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *adapter.PersonioAdapter, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *HolidayCalendarsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data HolidayCalendarsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var filter adapter.EmployeeFilter
+	for _, s := range data.Status {
+		filter.Statuses = append(filter.Statuses, s.ValueString())
+	}
+
+	calendars, err := d.client.GetHolidayCalendars(ctx, filter)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read holiday calendars, got error: %s", err))
+		return
+	}
+
+	data.HolidayCalendars = calendars
+	data.Id = utils.GetStableId("personio_holiday_calendars", data)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/jesse0michael/go-rest-assured/assured"
+)
+
+const testAccHolidayCalendarsDataSourceConfig = `
+data "personio_holiday_calendars" "test" {
+}
+`
+
+func TestAccHolidayCalendarsDataSource(t *testing.T) {
+	emps, _ := os.ReadFile("../../test/data/schedule_employees.json")
+	c := DefaultRestServerWith(assured.Call{
+		Path:       "/company/employees",
+		Method:     "GET",
+		StatusCode: 200,
+		Response:   emps,
+	})
+	defer c.Close()
+	t.Setenv("PERSONIO_API_URL", c.URL())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccHolidayCalendarsDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.personio_holiday_calendars.test", "holiday_calendars.#", "2"),
+					resource.TestCheckResourceAttr("data.personio_holiday_calendars.test", "holiday_calendars.0.id", "189"),
+					resource.TestCheckResourceAttr("data.personio_holiday_calendars.test", "holiday_calendars.0.name", "United Kingdom public holidays"),
+					resource.TestCheckNoResourceAttr("data.personio_holiday_calendars.test", "holiday_calendars.0.state"),
+					resource.TestCheckResourceAttr("data.personio_holiday_calendars.test", "holiday_calendars.0.headcount", "4"),
+					resource.TestCheckResourceAttr("data.personio_holiday_calendars.test", "holiday_calendars.1.id", "221"),
+					resource.TestCheckResourceAttr("data.personio_holiday_calendars.test", "holiday_calendars.1.country", "DE"),
+					resource.TestCheckResourceAttr("data.personio_holiday_calendars.test", "holiday_calendars.1.state", "Berlin"),
+					resource.TestCheckResourceAttr("data.personio_holiday_calendars.test", "holiday_calendars.1.employee_ids.#", "2"),
+					resource.TestCheckResourceAttr("data.personio_holiday_calendars.test", "holiday_calendars.1.employee_ids.0", "13649261"),
+					resource.TestCheckResourceAttr("data.personio_holiday_calendars.test", "holiday_calendars.1.employee_ids.1", "13649265"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,113 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/nicoangelo/terraform-provider-personio/internal/adapter"
+	"github.com/nicoangelo/terraform-provider-personio/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var (
+	_ datasource.DataSource = &OfficesDataSource{}
+)
+
+func NewOfficesDataSource() datasource.DataSource {
+	return &OfficesDataSource{}
+}
+
+// OfficesDataSource defines the data source implementation.
+type OfficesDataSource struct {
+	client *adapter.PersonioAdapter
+}
+
+// OfficesDataSourceModel describes the data source data model.
+type OfficesDataSourceModel struct {
+	Offices []adapter.Office `tfsdk:"offices"`
+	Status  []types.String   `tfsdk:"status"`
+	Id      types.String     `tfsdk:"id"`
+}
+
+func (d *OfficesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_offices"
+}
+
+func (d *OfficesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: `
+Offices data source
+
+Retrieves all offices with their employees. The Personio API has no endpoint for offices,
+so they are derived from the office of each employee: offices without employees are not returned,
+and the office attribute must be readable by the API credential. The API only provides the ID
+and name of an office.
+
+The offices are ordered by ID.
+`,
+		Attributes: map[string]schema.Attribute{
+			"offices": schema.ListNestedAttribute{
+				MarkdownDescription: "List of offices.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: officeAttributes,
+				},
+			},
+			"status": employeeStatusFilter("Only count employees with one of these statuses"),
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier derived from a hash of the arguments and the returned offices. It only changes when either of them changes.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *OfficesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*adapter.PersonioAdapter)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *adapter.PersonioAdapter, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *OfficesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data OfficesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var filter adapter.EmployeeFilter
+	for _, s := range data.Status {
+		filter.Statuses = append(filter.Statuses, s.ValueString())
+	}
+
+	offices, err := d.client.GetOffices(ctx, filter)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read offices, got error: %s", err))
+		return
+	}
+
+	data.Offices = offices
+	data.Id = utils.GetStableId("personio_offices", data)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/jesse0michael/go-rest-assured/assured"
+)
+
+const testAccOfficesDataSourceConfig = `
+data "personio_offices" "test" {
+}
+`
+
+func TestAccOfficesDataSource(t *testing.T) {
+	emps, _ := os.ReadFile("../../test/data/all_employees.json")
+	c := DefaultRestServerWith(assured.Call{
+		Path:       "/company/employees",
+		Method:     "GET",
+		StatusCode: 200,
+		Response:   emps,
+	})
+	defer c.Close()
+	t.Setenv("PERSONIO_API_URL", c.URL())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccOfficesDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.personio_offices.test", "offices.#", "2"),
+					resource.TestCheckResourceAttr("data.personio_offices.test", "offices.0.id", "1559799"),
+					resource.TestCheckResourceAttr("data.personio_offices.test", "offices.0.name", "London"),
+					resource.TestCheckResourceAttr("data.personio_offices.test", "offices.0.headcount", "31"),
+					resource.TestCheckResourceAttr("data.personio_offices.test", "offices.0.employee_ids.#", "31"),
+					resource.TestCheckResourceAttr("data.personio_offices.test", "offices.1.id", "1559801"),
+					resource.TestCheckResourceAttr("data.personio_offices.test", "offices.1.name", "Remote"),
+					resource.TestCheckResourceAttr("data.personio_offices.test", "offices.1.headcount", "2"),
+				),
+			},
+		},
+	})
+}
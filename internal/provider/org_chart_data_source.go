@@ -0,0 +1,126 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/nicoangelo/terraform-provider-personio/internal/adapter"
+	"github.com/nicoangelo/terraform-provider-personio/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var (
+	_ datasource.DataSource = &OrgChartDataSource{}
+)
+
+func NewOrgChartDataSource() datasource.DataSource {
+	return &OrgChartDataSource{}
+}
+
+// OrgChartDataSource defines the data source implementation.
+type OrgChartDataSource struct {
+	client *adapter.PersonioAdapter
+}
+
+// OrgChartDataSourceModel describes the data source data model.
+type OrgChartDataSourceModel struct {
+	Employees      []adapter.OrgChartEntry `tfsdk:"employees"`
+	RootIds        []types.Int64           `tfsdk:"root_ids"`
+	RootEmployeeId types.Int64             `tfsdk:"root_employee_id"`
+	Id             types.String            `tfsdk:"id"`
+}
+
+func (d *OrgChartDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_org_chart"
+}
+
+func (d *OrgChartDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: `
+Org chart data source
+
+Builds the reporting tree of all employees from their supervisors, and returns the chain of command
+and the reports of every employee. It requires the supervisor attribute to be readable by the API credential.
+
+Employees without a supervisor are the roots of the tree. Supervisor relations that cannot be part of a tree
+are ignored and reported as warnings:
+
+- If the supervisor of an employee is not among the employees, the employee is treated as a root.
+- If supervisors form a cycle, the employee with the lowest ID in the cycle is treated as a root.
+
+The employees are ordered by ID.
+`,
+		Attributes: map[string]schema.Attribute{
+			"employees": schema.ListNestedAttribute{
+				MarkdownDescription: "List of employees with their position in the org chart.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: orgChartEntryAttributes,
+				},
+			},
+			"root_ids": schema.ListAttribute{
+				MarkdownDescription: "IDs of the roots of the org chart, ordered by ID.",
+				ElementType:         types.Int64Type,
+				Computed:            true,
+			},
+			"root_employee_id": schema.Int64Attribute{
+				MarkdownDescription: "Only return the employee with this ID and its reports. " +
+					"The employee is the single root of the returned org chart: manager chains end at it, and depths are relative to it.",
+				Optional: true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier derived from a hash of the arguments and the returned org chart. It only changes when either of them changes.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *OrgChartDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*adapter.PersonioAdapter)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *adapter.PersonioAdapter, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *OrgChartDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data OrgChartDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	chart, err := d.client.GetOrgChart(ctx, data.RootEmployeeId.ValueInt64Pointer())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to build org chart, got error: %s", err))
+		return
+	}
+	for _, w := range chart.Warnings {
+		resp.Diagnostics.AddWarning("Inconsistent Org Chart", w)
+	}
+
+	data.Employees = chart.Entries
+	data.RootIds = chart.RootIds
+	data.Id = utils.GetStableId("personio_org_chart", data)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,95 @@
+package provider
+
+import (
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/jesse0michael/go-rest-assured/assured"
+)
+
+const (
+	testAccOrgChartDataSourceConfig = `
+data "personio_org_chart" "test" {
+}
+`
+	testAccOrgChartSubtreeDataSourceConfig = `
+data "personio_org_chart" "test" {
+	root_employee_id = 13649262
+}
+`
+	testAccOrgChartUnknownRootDataSourceConfig = `
+data "personio_org_chart" "test" {
+	root_employee_id = 42
+}
+`
+)
+
+func TestAccOrgChartDataSource(t *testing.T) {
+	employees, _ := os.ReadFile("../../test/data/all_employees.json")
+	c := DefaultRestServerWith(assured.Call{
+		Path:       "/company/employees",
+		Method:     "GET",
+		StatusCode: 200,
+		Response:   employees,
+	})
+	defer c.Close()
+	t.Setenv("PERSONIO_API_URL", c.URL())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+
+		Steps: []resource.TestStep{
+			// Must fail
+			{
+				Config:      testAccOrgChartUnknownRootDataSourceConfig,
+				ExpectError: regexp.MustCompile(`employee 42 not found`),
+			},
+
+			// Read testing
+			{
+				Config: testAccOrgChartDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.personio_org_chart.test", "employees.#", "34"),
+					resource.TestCheckResourceAttr("data.personio_org_chart.test", "root_ids.#", "2"),
+					resource.TestCheckResourceAttr("data.personio_org_chart.test", "root_ids.0", "13649261"),
+					resource.TestCheckResourceAttr("data.personio_org_chart.test", "root_ids.1", employeeId),
+					resource.TestCheckResourceAttr("data.personio_org_chart.test", "employees.0.employee_id", "13649261"),
+					resource.TestCheckNoResourceAttr("data.personio_org_chart.test", "employees.0.supervisor_id"),
+					resource.TestCheckResourceAttr("data.personio_org_chart.test", "employees.0.depth", "0"),
+					resource.TestCheckResourceAttr("data.personio_org_chart.test", "employees.0.manager_chain.#", "0"),
+					resource.TestCheckResourceAttr("data.personio_org_chart.test", "employees.0.direct_reports.#", "4"),
+					resource.TestCheckResourceAttr("data.personio_org_chart.test", "employees.0.direct_reports.0", "13649262"),
+					resource.TestCheckResourceAttr("data.personio_org_chart.test", "employees.0.all_reports.#", "32"),
+					resource.TestCheckResourceAttr("data.personio_org_chart.test", "employees.32.employee_id", "13649293"),
+					resource.TestCheckResourceAttr("data.personio_org_chart.test", "employees.32.supervisor_id", "13649274"),
+					resource.TestCheckResourceAttr("data.personio_org_chart.test", "employees.32.depth", "3"),
+					resource.TestCheckResourceAttr("data.personio_org_chart.test", "employees.32.manager_chain.#", "3"),
+					resource.TestCheckResourceAttr("data.personio_org_chart.test", "employees.32.manager_chain.0", "13649274"),
+					resource.TestCheckResourceAttr("data.personio_org_chart.test", "employees.32.manager_chain.1", "13649262"),
+					resource.TestCheckResourceAttr("data.personio_org_chart.test", "employees.32.manager_chain.2", "13649261"),
+					resource.TestCheckResourceAttr("data.personio_org_chart.test", "employees.32.all_reports.#", "0"),
+					resource.TestCheckResourceAttr("data.personio_org_chart.test", "employees.33.employee_id", employeeId),
+					resource.TestCheckResourceAttr("data.personio_org_chart.test", "employees.33.depth", "0"),
+				),
+			},
+			{
+				Config: testAccOrgChartSubtreeDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.personio_org_chart.test", "employees.#", "15"),
+					resource.TestCheckResourceAttr("data.personio_org_chart.test", "root_ids.#", "1"),
+					resource.TestCheckResourceAttr("data.personio_org_chart.test", "root_ids.0", "13649262"),
+					resource.TestCheckResourceAttr("data.personio_org_chart.test", "employees.0.employee_id", "13649262"),
+					resource.TestCheckNoResourceAttr("data.personio_org_chart.test", "employees.0.supervisor_id"),
+					resource.TestCheckResourceAttr("data.personio_org_chart.test", "employees.0.all_reports.#", "14"),
+					resource.TestCheckResourceAttr("data.personio_org_chart.test", "employees.14.employee_id", "13649293"),
+					resource.TestCheckResourceAttr("data.personio_org_chart.test", "employees.14.depth", "2"),
+					resource.TestCheckResourceAttr("data.personio_org_chart.test", "employees.14.manager_chain.#", "2"),
+					resource.TestCheckResourceAttr("data.personio_org_chart.test", "employees.14.manager_chain.1", "13649262"),
+				),
+			},
+		},
+	})
+}
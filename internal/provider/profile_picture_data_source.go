@@ -0,0 +1,135 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/nicoangelo/terraform-provider-personio/internal/adapter"
+	"github.com/nicoangelo/terraform-provider-personio/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var (
+	_ datasource.DataSource = &ProfilePictureDataSource{}
+)
+
+func NewProfilePictureDataSource() datasource.DataSource {
+	return &ProfilePictureDataSource{}
+}
+
+// ProfilePictureDataSource defines the data source implementation.
+type ProfilePictureDataSource struct {
+	client *adapter.PersonioAdapter
+}
+
+// ProfilePictureDataSourceModel describes the data source data model.
+type ProfilePictureDataSourceModel struct {
+	EmployeeId    types.Int64  `tfsdk:"employee_id"`
+	Width         types.Int64  `tfsdk:"width"`
+	Exists        types.Bool   `tfsdk:"exists"`
+	ContentBase64 types.String `tfsdk:"content_base64"`
+	ContentType   types.String `tfsdk:"content_type"`
+	Sha256        types.String `tfsdk:"sha256"`
+	Id            types.String `tfsdk:"id"`
+}
+
+func (d *ProfilePictureDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_profile_picture"
+}
+
+func (d *ProfilePictureDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: `
+Profile picture data source
+
+Retrieves the profile picture of an employee. The ` + "`profile_picture`" + ` attribute of an employee only
+contains the URL of the picture, which cannot be fetched without API credentials. This data source fetches
+the picture itself, e.g. to push it to other systems.
+
+The ` + "`profile_picture`" + ` attribute must be readable by the API credential. Employees without a picture
+are not an error: ` + "`exists`" + ` is false and the content attributes are null. An unknown ` + "`employee_id`" + `
+is an error.
+`,
+		Attributes: map[string]schema.Attribute{
+			"employee_id": schema.Int64Attribute{
+				MarkdownDescription: "ID of the employee.",
+				Required:            true,
+			},
+			"width": schema.Int64Attribute{
+				MarkdownDescription: "Width of the picture in pixels. The picture is scaled by Personio. If not set, the size chosen by Personio is returned.",
+				Optional:            true,
+				Validators:          []validator.Int64{int64validator.AtLeast(1)},
+			},
+			"exists": schema.BoolAttribute{
+				MarkdownDescription: "Whether the employee has a profile picture.",
+				Computed:            true,
+			},
+			"content_base64": schema.StringAttribute{
+				MarkdownDescription: "Base64-encoded content of the picture.",
+				Computed:            true,
+			},
+			"content_type": schema.StringAttribute{
+				MarkdownDescription: "Media type of the picture, e.g. `image/png`. Detected from the content if Personio does not declare it.",
+				Computed:            true,
+			},
+			"sha256": schema.StringAttribute{
+				MarkdownDescription: "Hex-encoded SHA-256 hash of the picture, e.g. to detect changes.",
+				Computed:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier derived from a hash of the arguments and the returned picture. It only changes when either of them changes.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *ProfilePictureDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*adapter.PersonioAdapter)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *adapter.PersonioAdapter, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ProfilePictureDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ProfilePictureDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	picture, err := d.client.GetProfilePicture(ctx, data.EmployeeId.ValueInt64(), data.Width.ValueInt64Pointer())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read profile picture, got error: %s", err))
+		return
+	}
+
+	data.Exists = picture.Exists
+	data.ContentBase64 = picture.ContentBase64
+	data.ContentType = picture.ContentType
+	data.Sha256 = picture.Sha256
+	data.Id = utils.GetStableId("personio_profile_picture", data)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,106 @@
+package provider
+
+import (
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/jesse0michael/go-rest-assured/assured"
+)
+
+const (
+	testAccProfilePictureDataSourceConfig = `
+data "personio_profile_picture" "test" {
+	employee_id = 13649297
+	width       = 64
+}
+`
+	testAccProfilePictureMissingDataSourceConfig = `
+data "personio_profile_picture" "test" {
+	employee_id = 13649290
+	width       = 64
+}
+`
+	testAccProfilePictureUnknownEmployeeDataSourceConfig = `
+data "personio_profile_picture" "test" {
+	employee_id = 99999
+	width       = 64
+}
+`
+	testAccProfilePictureInvalidWidthDataSourceConfig = `
+data "personio_profile_picture" "test" {
+	employee_id = 13649297
+	width       = 0
+}
+`
+)
+
+func TestAccProfilePictureDataSource(t *testing.T) {
+	png, _ := os.ReadFile("../../test/data/profile_picture.png")
+	emp, _ := os.ReadFile("../../test/data/one_employee.json")
+	c := DefaultRestServerWith(assured.Call{
+		Path:       "/company/employees/13649297/profile-picture/64",
+		Method:     "GET",
+		StatusCode: 200,
+		Headers:    map[string]string{"Content-Type": "image/png"},
+		Response:   png,
+	}, assured.Call{
+		Path:       "/company/employees/13649290/profile-picture/64",
+		Method:     "GET",
+		StatusCode: 404,
+	}, assured.Call{
+		Path:       "/company/employees/13649290",
+		Method:     "GET",
+		StatusCode: 200,
+		Response:   emp,
+	}, assured.Call{
+		Path:       "/company/employees/99999/profile-picture/64",
+		Method:     "GET",
+		StatusCode: 404,
+	}, assured.Call{
+		Path:       "/company/employees/99999",
+		Method:     "GET",
+		StatusCode: 404,
+	})
+	defer c.Close()
+	t.Setenv("PERSONIO_API_URL", c.URL())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+
+		Steps: []resource.TestStep{
+			// Must fail
+			{
+				Config:      testAccProfilePictureInvalidWidthDataSourceConfig,
+				ExpectError: regexp.MustCompile(`Attribute width value must be at least 1`),
+			},
+			{
+				Config:      testAccProfilePictureUnknownEmployeeDataSourceConfig,
+				ExpectError: regexp.MustCompile(`Unable to read profile picture, got error: employee 99999: 404 Not Found`),
+			},
+
+			// Read testing
+			{
+				Config: testAccProfilePictureDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.personio_profile_picture.test", "exists", "true"),
+					resource.TestCheckResourceAttr("data.personio_profile_picture.test", "content_type", "image/png"),
+					resource.TestCheckResourceAttr("data.personio_profile_picture.test", "content_base64", "iVBORw0KGgoAAAANSUhEUgAAAAEAAAABCAYAAAAfFcSJAAAAC0lEQVR4nGNgAAIAAAUAAXpeqz8AAAAASUVORK5CYII="),
+					resource.TestCheckResourceAttr("data.personio_profile_picture.test", "sha256", "43739c566e26fd7cb88f69d3864ea34740372f5ee99acac169e090beffbce5c6"),
+					resource.TestCheckResourceAttrSet("data.personio_profile_picture.test", "id"),
+				),
+			},
+			{
+				Config: testAccProfilePictureMissingDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.personio_profile_picture.test", "exists", "false"),
+					resource.TestCheckNoResourceAttr("data.personio_profile_picture.test", "content_base64"),
+					resource.TestCheckNoResourceAttr("data.personio_profile_picture.test", "content_type"),
+					resource.TestCheckNoResourceAttr("data.personio_profile_picture.test", "sha256"),
+				),
+			},
+		},
+	})
+}
@@ -4,11 +4,15 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/nicoangelo/terraform-provider-personio/internal/adapter"
 	"github.com/nicoangelo/terraform-provider-personio/internal/utils"
@@ -36,6 +40,11 @@ type PersonioProviderModel struct {
 	Endpoint     types.String `tfsdk:"api_base_url"`
 	ClientId     types.String `tfsdk:"client_id"`
 	ClientSecret types.String `tfsdk:"client_secret"`
+
+	MaxRetries        types.Int64  `tfsdk:"max_retries"`
+	RetryMaxWait      types.Int64  `tfsdk:"retry_max_wait"`
+	RequestsPerMinute types.Int64  `tfsdk:"requests_per_minute"`
+	Cache             types.String `tfsdk:"cache"`
 }
 
 func (p *PersonioProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -67,6 +76,46 @@ func (p *PersonioProvider) Schema(ctx context.Context, req provider.SchemaReques
 					adapter.ApiBaseUrlDefault),
 				Optional: true,
 			},
+			"max_retries": schema.Int64Attribute{
+				Description: fmt.Sprintf(
+					"Number of times a request is retried when it is throttled (HTTP 429), fails with a server error "+
+						"or a network error. Retries use exponential backoff with jitter. Defaults to `%d`.",
+					adapter.MaxRetriesDefault),
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"retry_max_wait": schema.Int64Attribute{
+				Description: fmt.Sprintf(
+					"Maximum time in seconds to wait between two attempts of a request. Also caps the delay announced "+
+						"by the `Retry-After` and `X-RateLimit-Reset` headers. Defaults to `%d`.",
+					int64(adapter.RetryMaxWaitDefault/time.Second)),
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"requests_per_minute": schema.Int64Attribute{
+				Description: "Maximum number of requests per minute sent to the Personio API by this provider instance. " +
+					"Unlimited if not set; the rate limit headers returned by Personio are honoured in any case.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"cache": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf(
+					"Caching of API responses across data sources. With `%s`, responses are kept for the duration of "+
+						"a Terraform run: once all employees have been read, single employee lookups are answered from "+
+						"memory, and identical requests in flight are sent only once. With `%s`, every data source sends "+
+						"its own requests. Defaults to `%s`.",
+					adapter.CacheModeRun, adapter.CacheModeNone, adapter.CacheModeDefault),
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(string(adapter.CacheModeRun), string(adapter.CacheModeNone)),
+				},
+			},
 		},
 	}
 }
@@ -84,7 +133,21 @@ func (p *PersonioProvider) Configure(ctx context.Context, req provider.Configure
 	client_secret := utils.CoalesceEmpty(data.ClientSecret.ValueString(), os.Getenv(clientSecretEnvKey))
 	apiBaseUrl := utils.CoalesceEmpty(os.Getenv(apiBaseUrlEnvKey), adapter.ApiBaseUrlDefault)
 
-	personioAdapter, err := adapter.NewAdapter(apiBaseUrl, client_id, client_secret)
+	opts := adapter.DefaultAdapterOptions()
+	if !data.MaxRetries.IsNull() {
+		opts.MaxRetries = int(data.MaxRetries.ValueInt64())
+	}
+	if !data.RetryMaxWait.IsNull() {
+		opts.RetryMaxWait = time.Duration(data.RetryMaxWait.ValueInt64()) * time.Second
+	}
+	if !data.RequestsPerMinute.IsNull() {
+		opts.RequestsPerMinute = int(data.RequestsPerMinute.ValueInt64())
+	}
+	if !data.Cache.IsNull() {
+		opts.Cache = adapter.CacheMode(data.Cache.ValueString())
+	}
+
+	personioAdapter, err := adapter.NewAdapter(apiBaseUrl, client_id, client_secret, opts)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to create Personio API client", err.Error())
 	}
@@ -100,6 +163,22 @@ func (p *PersonioProvider) DataSources(ctx context.Context) []func() datasource.
 	return []func() datasource.DataSource{
 		NewEmployeesDataSource,
 		NewEmployeeDataSource,
+		NewEmployeeAttributesDataSource,
+		NewDepartmentsDataSource,
+		NewTeamsDataSource,
+		NewOfficesDataSource,
+		NewCostCentersDataSource,
+		NewTimeOffTypesDataSource,
+		NewAbsencesDataSource,
+		NewAbsenceBalancesDataSource,
+		NewAttendancesDataSource,
+		NewAttendanceProjectsDataSource,
+		NewDocumentCategoriesDataSource,
+		NewCustomReportDataSource,
+		NewOrgChartDataSource,
+		NewWorkSchedulesDataSource,
+		NewHolidayCalendarsDataSource,
+		NewProfilePictureDataSource,
 	}
 }
 
@@ -1,20 +1,28 @@
 package provider
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/nicoangelo/terraform-provider-personio/internal/adapter"
 	"github.com/nicoangelo/terraform-provider-personio/internal/formatter"
 	"github.com/nicoangelo/terraform-provider-personio/internal/utils"
 )
 
 var (
-	employeeIdRequired = schema.NumberAttribute{
-		Description: "Personio Employee ID",
-		Required:    true,
+	employeeStatuses = []string{"active", "inactive", "onboarding", "leave"}
+
+	employeeIdLookup = schema.Int64Attribute{
+		Description: "Personio Employee ID of the employee to look up",
+		Optional:    true,
+		Computed:    true,
 	}
-	employeeIdComputed = schema.NumberAttribute{
+	employeeIdComputed = schema.Int64Attribute{
 		Description: "Personio Employee ID",
 		Computed:    true,
 	}
@@ -68,6 +76,10 @@ var (
 			Description: "Office name",
 			Computed:    true,
 		},
+		"office_id": schema.Int64Attribute{
+			Description: "Office ID",
+			Computed:    true,
+		},
 		"team": schema.StringAttribute{
 			Description: "Team name",
 			Computed:    true,
@@ -128,6 +140,146 @@ var (
 		},
 	}
 
+	typedAttributeAttributes = map[string]schema.Attribute{
+		"type": schema.StringAttribute{
+			Description: "Personio type of the attribute (`standard`, `multiline`, `link`, `list`, `integer`, `decimal`, `date` or `tags`)",
+			Computed:    true,
+		},
+		"string_value": schema.StringAttribute{
+			Description: "Value of `standard`, `multiline`, `link` and `list` attributes. For `list` attributes, this is the selected option. For `date` attributes, the RFC3339 formatted date in the timezone given by Personio, so that it matches `date_value`.",
+			Computed:    true,
+		},
+		"number_value": schema.NumberAttribute{
+			Description: "Value of `integer` and `decimal` attributes",
+			Computed:    true,
+		},
+		"date_value": schema.SingleNestedAttribute{
+			Description: "Calendar date of `date` attributes",
+			Computed:    true,
+			Attributes: map[string]schema.Attribute{
+				"year": schema.Int64Attribute{
+					Description: "Year",
+					Computed:    true,
+				},
+				"month": schema.Int64Attribute{
+					Description: "Month (1-12)",
+					Computed:    true,
+				},
+				"day": schema.Int64Attribute{
+					Description: "Day of the month",
+					Computed:    true,
+				},
+			},
+		},
+		"set_value": schema.SetAttribute{
+			Description: "Selected values of `tags` attributes",
+			ElementType: types.StringType,
+			Computed:    true,
+		},
+		"options": schema.ListAttribute{
+			Description: "Allowed values of `list` and `tags` attributes, as defined in the attribute catalog of the tenant. " +
+				"Null if the catalog cannot be read, e.g. because the API credential may not read it. A warning is shown in that case.",
+			ElementType: types.StringType,
+			Computed:    true,
+		},
+	}
+
+	workScheduleAttributes = map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			Description: "Work schedule ID",
+			Computed:    true,
+		},
+		"name": schema.StringAttribute{
+			Description: "Name of the work schedule",
+			Computed:    true,
+		},
+		"valid_from": schema.StringAttribute{
+			Description: "Date from which the work schedule is valid",
+			Computed:    true,
+		},
+		"monday": schema.StringAttribute{
+			Description: "Working hours on Mondays (HH:MM)",
+			Computed:    true,
+		},
+		"tuesday": schema.StringAttribute{
+			Description: "Working hours on Tuesdays (HH:MM)",
+			Computed:    true,
+		},
+		"wednesday": schema.StringAttribute{
+			Description: "Working hours on Wednesdays (HH:MM)",
+			Computed:    true,
+		},
+		"thursday": schema.StringAttribute{
+			Description: "Working hours on Thursdays (HH:MM)",
+			Computed:    true,
+		},
+		"friday": schema.StringAttribute{
+			Description: "Working hours on Fridays (HH:MM)",
+			Computed:    true,
+		},
+		"saturday": schema.StringAttribute{
+			Description: "Working hours on Saturdays (HH:MM)",
+			Computed:    true,
+		},
+		"sunday": schema.StringAttribute{
+			Description: "Working hours on Sundays (HH:MM)",
+			Computed:    true,
+		},
+	}
+
+	holidayCalendarAttributes = map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			Description: "Holiday calendar ID",
+			Computed:    true,
+		},
+		"name": schema.StringAttribute{
+			Description: "Name of the holiday calendar",
+			Computed:    true,
+		},
+		"country": schema.StringAttribute{
+			Description: "Country of the holiday calendar",
+			Computed:    true,
+		},
+		"state": schema.StringAttribute{
+			Description: "State of the holiday calendar",
+			Computed:    true,
+		},
+	}
+
+	absenceEntitlementAttributes = map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			Description: "Time-off type ID",
+			Computed:    true,
+		},
+		"name": schema.StringAttribute{
+			Description: "Name of the time-off type",
+			Computed:    true,
+		},
+		"category": schema.StringAttribute{
+			Description: "Category of the time-off type (e.g. `paid_vacation`)",
+			Computed:    true,
+		},
+		"entitlement": schema.Float64Attribute{
+			Description: "Entitlement of the employee for the time-off type",
+			Computed:    true,
+		},
+	}
+
+	costCenterAttributes = map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			Description: "Cost center ID",
+			Computed:    true,
+		},
+		"name": schema.StringAttribute{
+			Description: "Name of the cost center",
+			Computed:    true,
+		},
+		"percentage": schema.Float64Attribute{
+			Description: "Share of the employee's costs that is assigned to the cost center, in percent",
+			Computed:    true,
+		},
+	}
+
 	employeeRootAttributes = map[string]schema.Attribute{
 		"created_at": schema.StringAttribute{
 			Description: "Creation date of the employee record",
@@ -137,10 +289,46 @@ var (
 			Description: "Last modification date of employee record",
 			Computed:    true,
 		},
+		"content_hash": schema.StringAttribute{
+			Description: "SHA-256 hash of the employee record as returned by Personio. Changes only when the record changes, independent of formatters.",
+			Computed:    true,
+		},
 		"status": schema.StringAttribute{
 			Description: "Status of the employee (active,...)",
 			Computed:    true,
 		},
+		"date_of_birth": schema.StringAttribute{
+			Description: "Date of birth",
+			Computed:    true,
+		},
+		"profile_picture": schema.StringAttribute{
+			Description: "URL of the profile picture. Use the `personio_profile_picture` data source to fetch the picture itself",
+			Computed:    true,
+		},
+		"work_schedule": schema.SingleNestedAttribute{
+			Description: "Work schedule of the employee",
+			Computed:    true,
+			Attributes:  workScheduleAttributes,
+		},
+		"holiday_calendar": schema.SingleNestedAttribute{
+			Description: "Public holiday calendar of the employee",
+			Computed:    true,
+			Attributes:  holidayCalendarAttributes,
+		},
+		"absence_entitlement": schema.ListNestedAttribute{
+			Description: "Absence entitlement of the employee per time-off type",
+			Computed:    true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: absenceEntitlementAttributes,
+			},
+		},
+		"cost_centers": schema.ListNestedAttribute{
+			Description: "Cost centers the employee is assigned to",
+			Computed:    true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: costCenterAttributes,
+			},
+		},
 		"dynamic_attributes": schema.MapAttribute{
 			Description: "Additional dynamic attributes of the employee.",
 			ElementType: types.StringType,
@@ -153,6 +341,25 @@ var (
 			},
 			Computed: true,
 		},
+		"dynamic_attributes_typed": schema.MapNestedAttribute{
+			Description: "Dynamic attributes of the employee with values that keep their Personio type. " +
+				"Each value is an object with one field per kind of value, of which only those matching `type` are set.",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: typedAttributeAttributes,
+			},
+			Computed: true,
+		},
+		"dynamic_attributes_by_label": schema.MapAttribute{
+			Description: "Dynamic attributes of the employee, keyed by their label instead of their key. " +
+				"Labels that are shared by several attributes are left out.",
+			ElementType: types.StringType,
+			Computed:    true,
+		},
+		"attribute_labels": schema.MapAttribute{
+			Description: "Human readable labels of all employee attributes, keyed by attribute key (e.g. `dynamic_123456`).",
+			ElementType: types.StringType,
+			Computed:    true,
+		},
 		"profile": schema.SingleNestedAttribute{
 			Attributes:  profileAttributes,
 			Description: "Public profile attributes of an employee",
@@ -168,7 +375,468 @@ var (
 			Description: "Salary data of the employee",
 			Computed:    true,
 		}}
-	employeeAttributes = utils.MergeMaps(basicEmployeeAttributes, employeeRootAttributes)
+	employeeRecordAttributes = utils.MergeMaps(basicEmployeeAttributes, employeeRootAttributes)
+	employeeAttributes       = utils.MergeMaps(employeeRecordAttributes, map[string]schema.Attribute{
+		"supervisor_chain": schema.ListNestedAttribute{
+			Description: "Full records of the supervisor, the supervisor's supervisor and so on, up to `expand_supervisor_depth` levels. " +
+				"Null if `expand_supervisor_depth` is not set.",
+			Computed: true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: employeeRecordAttributes,
+			},
+		},
+	})
+
+	employeeAttributeAttributes = map[string]schema.Attribute{
+		"key": schema.StringAttribute{
+			Description: "Key of the attribute, as used in the employee attributes (e.g. `first_name` or `dynamic_123456`)",
+			Computed:    true,
+		},
+		"universal_id": schema.StringAttribute{
+			Description: "Universal ID of the attribute, which is the same across tenants. Only set for preset attributes.",
+			Computed:    true,
+		},
+		"label": schema.StringAttribute{
+			Description: "Human readable label of the attribute",
+			Computed:    true,
+		},
+		"type": schema.StringAttribute{
+			Description: "Personio type of the attribute (`standard`, `multiline`, `link`, `list`, `integer`, `decimal`, `date` or `tags`)",
+			Computed:    true,
+		},
+		"options": schema.ListAttribute{
+			Description: "Allowed values of `list` and `tags` attributes",
+			ElementType: types.StringType,
+			Computed:    true,
+		},
+	}
+
+	departmentAttributes = map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			Description: "Department ID",
+			Computed:    true,
+		},
+		"name": schema.StringAttribute{
+			Description: "Department name",
+			Computed:    true,
+		},
+		"employee_ids": schema.ListAttribute{
+			Description: "IDs of the employees of the department, in ascending order",
+			ElementType: types.Int64Type,
+			Computed:    true,
+		},
+		"headcount": schema.Int64Attribute{
+			Description: "Number of employees of the department",
+			Computed:    true,
+		},
+	}
+
+	teamAttributes = map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			Description: "Team ID",
+			Computed:    true,
+		},
+		"name": schema.StringAttribute{
+			Description: "Team name",
+			Computed:    true,
+		},
+		"employee_ids": schema.ListAttribute{
+			Description: "IDs of the employees of the team, in ascending order",
+			ElementType: types.Int64Type,
+			Computed:    true,
+		},
+		"employee_emails": schema.ListAttribute{
+			Description: "Email addresses of the employees of the team, in the order of `employee_ids`",
+			ElementType: types.StringType,
+			Computed:    true,
+		},
+		"headcount": schema.Int64Attribute{
+			Description: "Number of employees of the team",
+			Computed:    true,
+		},
+		"lead": schema.SingleNestedAttribute{
+			Attributes:  basicEmployeeAttributes,
+			Description: "Lead of the team, derived as the supervisor of most of its employees. Ties are resolved in favour of the lowest employee ID. Not set if no employee has a supervisor.",
+			Computed:    true,
+		},
+	}
+
+	officeAttributes = map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			Description: "Office ID",
+			Computed:    true,
+		},
+		"name": schema.StringAttribute{
+			Description: "Office name",
+			Computed:    true,
+		},
+		"employee_ids": schema.ListAttribute{
+			Description: "IDs of the employees of the office, in ascending order",
+			ElementType: types.Int64Type,
+			Computed:    true,
+		},
+		"headcount": schema.Int64Attribute{
+			Description: "Number of employees of the office",
+			Computed:    true,
+		},
+	}
+
+	costCenterSummaryAttributes = map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			Description: "Cost center ID",
+			Computed:    true,
+		},
+		"name": schema.StringAttribute{
+			Description: "Name of the cost center",
+			Computed:    true,
+		},
+		"employee_ids": schema.ListAttribute{
+			Description: "IDs of the employees assigned to the cost center, in ascending order",
+			ElementType: types.Int64Type,
+			Computed:    true,
+		},
+		"headcount": schema.Int64Attribute{
+			Description: "Number of employees assigned to the cost center",
+			Computed:    true,
+		},
+		"allocations": schema.ListNestedAttribute{
+			Description: "Share of the costs of each employee that is assigned to the cost center, in the order of `employee_ids`",
+			Computed:    true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"employee_id": schema.Int64Attribute{
+						Description: "Employee ID",
+						Computed:    true,
+					},
+					"percentage": schema.Float64Attribute{
+						Description: "Share of the employee's costs that is assigned to the cost center, in percent",
+						Computed:    true,
+					},
+				},
+			},
+		},
+	}
+
+	timeOffTypeAttributes = map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			Description: "Time-off type ID",
+			Computed:    true,
+		},
+		"name": schema.StringAttribute{
+			Description: "Name of the time-off type",
+			Computed:    true,
+		},
+		"category": schema.StringAttribute{
+			Description: "Category of the time-off type (e.g. `paid_vacation` or `sick_leave`)",
+			Computed:    true,
+		},
+		"unit": schema.StringAttribute{
+			Description: "Unit in which absences of this type are measured (`day` or `hour`)",
+			Computed:    true,
+		},
+		"half_days_enabled": schema.BoolAttribute{
+			Description: "Whether half days can be requested",
+			Computed:    true,
+		},
+		"approval_required": schema.BoolAttribute{
+			Description: "Whether absences of this type require approval",
+			Computed:    true,
+		},
+	}
+
+	absenceAttributes = map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			Description: "Absence ID",
+			Computed:    true,
+		},
+		"employee_id": schema.Int64Attribute{
+			Description: "ID of the absent employee",
+			Computed:    true,
+		},
+		"status": schema.StringAttribute{
+			Description: "Status of the absence (e.g. `approved`, `pending` or `rejected`)",
+			Computed:    true,
+		},
+		"comment": schema.StringAttribute{
+			Description: "Comment of the absence",
+			Computed:    true,
+		},
+		"start_date": schema.StringAttribute{
+			Description: "First day of the absence (YYYY-MM-DD)",
+			Computed:    true,
+		},
+		"end_date": schema.StringAttribute{
+			Description: "Last day of the absence (YYYY-MM-DD)",
+			Computed:    true,
+		},
+		"half_day_start": schema.BoolAttribute{
+			Description: "Whether the absence starts in the middle of the first day",
+			Computed:    true,
+		},
+		"half_day_end": schema.BoolAttribute{
+			Description: "Whether the absence ends in the middle of the last day",
+			Computed:    true,
+		},
+		"days_count": schema.Float64Attribute{
+			Description: "Number of absent working days",
+			Computed:    true,
+		},
+		"time_off_type": schema.SingleNestedAttribute{
+			Description: "Time-off type of the absence",
+			Computed:    true,
+			Attributes: map[string]schema.Attribute{
+				"id": schema.Int64Attribute{
+					Description: "Time-off type ID",
+					Computed:    true,
+				},
+				"name": schema.StringAttribute{
+					Description: "Name of the time-off type",
+					Computed:    true,
+				},
+				"category": schema.StringAttribute{
+					Description: "Category of the time-off type",
+					Computed:    true,
+				},
+			},
+		},
+		"created_at": schema.StringAttribute{
+			Description: "Creation date of the absence",
+			Computed:    true,
+		},
+		"updated_at": schema.StringAttribute{
+			Description: "Last modification date of the absence",
+			Computed:    true,
+		},
+	}
+
+	absenceBalanceAttributes = map[string]schema.Attribute{
+		"employee_id": schema.Int64Attribute{
+			Description: "Employee ID",
+			Computed:    true,
+		},
+		"time_off_type_id": schema.Int64Attribute{
+			Description: "Time-off type ID",
+			Computed:    true,
+		},
+		"name": schema.StringAttribute{
+			Description: "Name of the time-off type",
+			Computed:    true,
+		},
+		"category": schema.StringAttribute{
+			Description: "Category of the time-off type",
+			Computed:    true,
+		},
+		"balance": schema.Float64Attribute{
+			Description: "Balance of the employee for the time-off type, in the unit of the time-off type",
+			Computed:    true,
+		},
+	}
+
+	attendanceAttributes = map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			Description: "Attendance ID",
+			Computed:    true,
+		},
+		"employee_id": schema.Int64Attribute{
+			Description: "ID of the attending employee",
+			Computed:    true,
+		},
+		"date": schema.StringAttribute{
+			Description: "Day of the attendance (YYYY-MM-DD)",
+			Computed:    true,
+		},
+		"start_time": schema.StringAttribute{
+			Description: "Start time of the attendance (HH:MM)",
+			Computed:    true,
+		},
+		"end_time": schema.StringAttribute{
+			Description: "End time of the attendance (HH:MM), null while the attendance is ongoing",
+			Computed:    true,
+		},
+		"break_minutes": schema.Int64Attribute{
+			Description: "Duration of the breaks in minutes",
+			Computed:    true,
+		},
+		"comment": schema.StringAttribute{
+			Description: "Comment of the attendance",
+			Computed:    true,
+		},
+		"project_id": schema.Int64Attribute{
+			Description: "ID of the attendance project the time was recorded for",
+			Computed:    true,
+		},
+		"status": schema.StringAttribute{
+			Description: "Status of the attendance (e.g. `confirmed`, `pending` or `rejected`)",
+			Computed:    true,
+		},
+		"updated_at": schema.StringAttribute{
+			Description: "Last modification date of the attendance",
+			Computed:    true,
+		},
+	}
+
+	attendanceProjectAttributes = map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			Description: "Project ID",
+			Computed:    true,
+		},
+		"name": schema.StringAttribute{
+			Description: "Name of the project",
+			Computed:    true,
+		},
+		"active": schema.BoolAttribute{
+			Description: "Whether attendances can be recorded for the project",
+			Computed:    true,
+		},
+		"created_at": schema.StringAttribute{
+			Description: "Creation date of the project",
+			Computed:    true,
+		},
+		"updated_at": schema.StringAttribute{
+			Description: "Last modification date of the project",
+			Computed:    true,
+		},
+	}
+
+	documentCategoryAttributes = map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			Description: "Document category ID",
+			Computed:    true,
+		},
+		"name": schema.StringAttribute{
+			Description: "Name of the document category",
+			Computed:    true,
+		},
+	}
+
+	customReportColumnAttributes = map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "Column ID, the key of the column in the rows",
+			Computed:    true,
+		},
+		"label": schema.StringAttribute{
+			Description: "Human readable label of the column",
+			Computed:    true,
+		},
+		"type": schema.StringAttribute{
+			Description: "Personio type of the column values (e.g. `standard`, `date` or `integer`)",
+			Computed:    true,
+		},
+	}
+
+	orgChartEntryAttributes = map[string]schema.Attribute{
+		"employee_id": schema.Int64Attribute{
+			Description: "Employee ID",
+			Computed:    true,
+		},
+		"supervisor_id": schema.Int64Attribute{
+			Description: "ID of the supervisor in the org chart, null for the roots",
+			Computed:    true,
+		},
+		"manager_chain": schema.ListAttribute{
+			Description: "IDs of the managers of the employee, ordered from the supervisor up to the root",
+			ElementType: types.Int64Type,
+			Computed:    true,
+		},
+		"direct_reports": schema.ListAttribute{
+			Description: "IDs of the employees that report directly to the employee, ordered by ID",
+			ElementType: types.Int64Type,
+			Computed:    true,
+		},
+		"all_reports": schema.ListAttribute{
+			Description: "IDs of the employees that report directly or indirectly to the employee, ordered by ID",
+			ElementType: types.Int64Type,
+			Computed:    true,
+		},
+		"depth": schema.Int64Attribute{
+			Description: "Number of managers above the employee, 0 for the roots",
+			Computed:    true,
+		},
+	}
+
+	workScheduleSummaryAttributes = map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			Description: "Work schedule ID",
+			Computed:    true,
+		},
+		"name": schema.StringAttribute{
+			Description: "Name of the work schedule",
+			Computed:    true,
+		},
+		"valid_from": schema.StringAttribute{
+			Description: "First day the work schedule is valid (YYYY-MM-DD)",
+			Computed:    true,
+		},
+		"monday": schema.Float64Attribute{
+			Description: "Working hours on Mondays, e.g. `7.5` for 07:30",
+			Computed:    true,
+		},
+		"tuesday": schema.Float64Attribute{
+			Description: "Working hours on Tuesdays, e.g. `7.5` for 07:30",
+			Computed:    true,
+		},
+		"wednesday": schema.Float64Attribute{
+			Description: "Working hours on Wednesdays, e.g. `7.5` for 07:30",
+			Computed:    true,
+		},
+		"thursday": schema.Float64Attribute{
+			Description: "Working hours on Thursdays, e.g. `7.5` for 07:30",
+			Computed:    true,
+		},
+		"friday": schema.Float64Attribute{
+			Description: "Working hours on Fridays, e.g. `7.5` for 07:30",
+			Computed:    true,
+		},
+		"saturday": schema.Float64Attribute{
+			Description: "Working hours on Saturdays, e.g. `7.5` for 07:30",
+			Computed:    true,
+		},
+		"sunday": schema.Float64Attribute{
+			Description: "Working hours on Sundays, e.g. `7.5` for 07:30",
+			Computed:    true,
+		},
+		"weekly_hours": schema.Float64Attribute{
+			Description: "Working hours per week, the sum of the working hours of all weekdays. Null if the hours of any weekday are unknown or not a time in HH:MM format between 00:00 and 24:00",
+			Computed:    true,
+		},
+		"employee_ids": schema.ListAttribute{
+			Description: "IDs of the employees with the work schedule, in ascending order",
+			ElementType: types.Int64Type,
+			Computed:    true,
+		},
+		"headcount": schema.Int64Attribute{
+			Description: "Number of employees with the work schedule",
+			Computed:    true,
+		},
+	}
+
+	holidayCalendarSummaryAttributes = map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			Description: "Holiday calendar ID",
+			Computed:    true,
+		},
+		"name": schema.StringAttribute{
+			Description: "Name of the holiday calendar",
+			Computed:    true,
+		},
+		"country": schema.StringAttribute{
+			Description: "Country code of the holiday calendar",
+			Computed:    true,
+		},
+		"state": schema.StringAttribute{
+			Description: "State or region of the holiday calendar",
+			Computed:    true,
+		},
+		"employee_ids": schema.ListAttribute{
+			Description: "IDs of the employees with the holiday calendar, in ascending order",
+			ElementType: types.Int64Type,
+			Computed:    true,
+		},
+		"headcount": schema.Int64Attribute{
+			Description: "Number of employees with the holiday calendar",
+			Computed:    true,
+		},
+	}
 
 	blocks = map[string]schema.Block{
 		"format": schema.SetNestedBlock{
@@ -177,7 +845,7 @@ var (
 				Attributes: map[string]schema.Attribute{
 					"attribute": schema.StringAttribute{
 						Required:    true,
-						Description: "The dynamic attribute key that should be formatted.",
+						Description: "The key (e.g. `dynamic_123456`) or label of the dynamic attribute that should be formatted.",
 					},
 					"phonenumber": schema.SingleNestedAttribute{
 						Optional: true,
@@ -207,3 +875,72 @@ Can be one of the following values (example is the number of the Google Switzerl
 		},
 	}
 )
+
+var (
+	employeeLookupBlocks = map[string]schema.Block{
+		"match": schema.SingleNestedBlock{
+			Description: "Look up the employee by the value of a dynamic attribute. Preset attributes (e.g. `first_name` or `department`) and tag attributes cannot be matched.",
+			Attributes: map[string]schema.Attribute{
+				"attribute": schema.StringAttribute{
+					Optional:    true,
+					Description: "The key (e.g. `dynamic_123456`) or label of the dynamic attribute. Required in the block.",
+					Validators: []validator.String{
+						stringvalidator.NoneOf(adapter.PresetAttributeKeys...),
+					},
+				},
+				"value": schema.StringAttribute{
+					Optional:    true,
+					Description: "The value of the attribute, compared with its string representation. Required in the block.",
+				},
+			},
+			Validators: []validator.Object{
+				objectvalidator.AlsoRequires(path.MatchRelative().AtName("attribute"), path.MatchRelative().AtName("value")),
+			},
+		},
+	}
+
+	employeesFilterBlocks = map[string]schema.Block{
+		"dynamic_attribute": schema.SetNestedBlock{
+			Description: "Only return employees whose dynamic attribute has the given value. Each attribute can only be filtered by one block.",
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"attribute": schema.StringAttribute{
+						Required:    true,
+						Description: "The key (e.g. `dynamic_123456`) or label of the dynamic attribute.",
+					},
+					"value": schema.StringAttribute{
+						Required:    true,
+						Description: "The expected value, compared with the string representation of the attribute.",
+					},
+				},
+			},
+		},
+	}
+)
+
+// employeeStatusFilter returns the schema of an optional list of employee statuses to filter by.
+func employeeStatusFilter(description string) schema.ListAttribute {
+	return schema.ListAttribute{
+		MarkdownDescription: description + " (" + utils.QuoteJoin(employeeStatuses) + ").",
+		ElementType:         types.StringType,
+		Optional:            true,
+		Validators: []validator.List{
+			listvalidator.ValueStringsAre(stringvalidator.OneOf(employeeStatuses...)),
+		},
+	}
+}
+
+// expandSupervisorDepth returns the schema of the optional argument that sets
+// how many levels of supervisors are resolved into full employee records.
+func expandSupervisorDepth() schema.Int64Attribute {
+	return schema.Int64Attribute{
+		MarkdownDescription: "Number of levels of supervisors to resolve into full employee records in `supervisor_chain`, " +
+			"e.g. `2` for the supervisor and the supervisor's supervisor. Supervisors are taken from the employees that are already fetched where possible. " +
+			"Otherwise each supervisor costs one API request, unless the list of all employees has been read before with the provider's response cache enabled (`cache = \"run\"`). " +
+			"A chain ends early, with a warning, at a supervisor that Personio refuses, e.g. because they were terminated or may not be read by the API credential.",
+		Optional: true,
+		Validators: []validator.Int64{
+			int64validator.AtLeast(1),
+		},
+	}
+}
@@ -0,0 +1,121 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/nicoangelo/terraform-provider-personio/internal/adapter"
+	"github.com/nicoangelo/terraform-provider-personio/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var (
+	_ datasource.DataSource = &TeamsDataSource{}
+)
+
+func NewTeamsDataSource() datasource.DataSource {
+	return &TeamsDataSource{}
+}
+
+// TeamsDataSource defines the data source implementation.
+type TeamsDataSource struct {
+	client *adapter.PersonioAdapter
+}
+
+// TeamsDataSourceModel describes the data source data model.
+type TeamsDataSourceModel struct {
+	Teams        []adapter.Team `tfsdk:"teams"`
+	DepartmentId types.Int64    `tfsdk:"department_id"`
+	Status       []types.String `tfsdk:"status"`
+	Id           types.String   `tfsdk:"id"`
+}
+
+func (d *TeamsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_teams"
+}
+
+func (d *TeamsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: `
+Teams data source
+
+Retrieves all teams with their employees and a derived team lead. The Personio API has no endpoint for teams,
+so they are derived from the team of each employee: teams without employees are not returned,
+and the team attribute must be readable by the API credential.
+
+The lead of a team is the supervisor of most of its employees. It requires the supervisor attribute to be readable.
+
+The teams are ordered by ID.
+`,
+		Attributes: map[string]schema.Attribute{
+			"teams": schema.ListNestedAttribute{
+				MarkdownDescription: "List of teams.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: teamAttributes,
+				},
+			},
+			"status": employeeStatusFilter("Only count employees with one of these statuses"),
+			"department_id": schema.Int64Attribute{
+				MarkdownDescription: "Only count employees of the department with this ID. " +
+					"Teams that span several departments are returned with the employees of this department only.",
+				Optional: true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier derived from a hash of the arguments and the returned teams. It only changes when either of them changes.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *TeamsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*adapter.PersonioAdapter)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *adapter.PersonioAdapter, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *TeamsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data TeamsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var filter adapter.EmployeeFilter
+	for _, s := range data.Status {
+		filter.Statuses = append(filter.Statuses, s.ValueString())
+	}
+	filter.DepartmentId = data.DepartmentId.ValueInt64Pointer()
+
+	teams, err := d.client.GetTeams(ctx, filter)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read teams, got error: %s", err))
+		return
+	}
+
+	data.Teams = teams
+	data.Id = utils.GetStableId("personio_teams", data)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
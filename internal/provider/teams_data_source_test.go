@@ -0,0 +1,73 @@
+package provider
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/jesse0michael/go-rest-assured/assured"
+)
+
+const (
+	testAccTeamsDataSourceConfig = `
+data "personio_teams" "test" {
+}
+`
+	testAccTeamsOfDepartmentDataSourceConfig = `
+data "personio_teams" "test" {
+	department_id = 4090747
+}
+`
+)
+
+func TestAccTeamsDataSource(t *testing.T) {
+	emps, _ := os.ReadFile("../../test/data/all_employees.json")
+	c := DefaultRestServerWith(assured.Call{
+		Path:       "/company/employees",
+		Method:     "GET",
+		StatusCode: 200,
+		Response:   emps,
+	})
+	defer c.Close()
+	t.Setenv("PERSONIO_API_URL", c.URL())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccTeamsDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.personio_teams.test", "teams.#", "11"),
+					resource.TestCheckResourceAttr("data.personio_teams.test", "teams.0.id", "1786270"),
+					resource.TestCheckResourceAttr("data.personio_teams.test", "teams.0.name", "Controlling"),
+					resource.TestCheckResourceAttr("data.personio_teams.test", "teams.0.headcount", "2"),
+					resource.TestCheckResourceAttr("data.personio_teams.test", "teams.0.employee_ids.0", "13649265"),
+					resource.TestCheckResourceAttr("data.personio_teams.test", "teams.0.employee_emails.0", "will.foster@demo-sample.com"),
+					resource.TestCheckResourceAttr("data.personio_teams.test", "teams.0.employee_ids.1", "13649292"),
+					resource.TestCheckResourceAttr("data.personio_teams.test", "teams.0.employee_emails.1", "alan.foster@demo-sample.com"),
+					resource.TestCheckResourceAttr("data.personio_teams.test", "teams.0.lead.id", "13649268"),
+					resource.TestCheckResourceAttr("data.personio_teams.test", "teams.0.lead.email", "matilda.ponder@demo-sample.com"),
+					// supervisors of the management team: 4x 13649261, 3x 13649262
+					resource.TestCheckResourceAttr("data.personio_teams.test", "teams.1.name", "Management"),
+					resource.TestCheckResourceAttr("data.personio_teams.test", "teams.1.headcount", "8"),
+					resource.TestCheckResourceAttr("data.personio_teams.test", "teams.1.lead.id", "13649261"),
+				),
+			},
+			{
+				Config: testAccTeamsOfDepartmentDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.personio_teams.test", "teams.#", "3"),
+					resource.TestCheckResourceAttr("data.personio_teams.test", "teams.0.name", "Management"),
+					resource.TestCheckResourceAttr("data.personio_teams.test", "teams.0.employee_ids.#", "1"),
+					resource.TestCheckResourceAttr("data.personio_teams.test", "teams.0.employee_ids.0", "13649264"),
+					resource.TestCheckResourceAttr("data.personio_teams.test", "teams.1.name", "Development"),
+					resource.TestCheckResourceAttr("data.personio_teams.test", "teams.2.name", "Product"),
+					resource.TestCheckResourceAttr("data.personio_teams.test", "teams.2.lead.id", "13649264"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/nicoangelo/terraform-provider-personio/internal/adapter"
+	"github.com/nicoangelo/terraform-provider-personio/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var (
+	_ datasource.DataSource = &TimeOffTypesDataSource{}
+)
+
+func NewTimeOffTypesDataSource() datasource.DataSource {
+	return &TimeOffTypesDataSource{}
+}
+
+// TimeOffTypesDataSource defines the data source implementation.
+type TimeOffTypesDataSource struct {
+	client *adapter.PersonioAdapter
+}
+
+// TimeOffTypesDataSourceModel describes the data source data model.
+type TimeOffTypesDataSourceModel struct {
+	TimeOffTypes []adapter.TimeOffType `tfsdk:"time_off_types"`
+	Id           types.String          `tfsdk:"id"`
+}
+
+func (d *TimeOffTypesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_time_off_types"
+}
+
+func (d *TimeOffTypesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: `
+Time-off types data source
+
+Retrieves the time-off types of the Personio tenant, e.g. paid vacation or sick leave.
+Use it to look up the ID of a time-off type by its name or category.
+`,
+		Attributes: map[string]schema.Attribute{
+			"time_off_types": schema.ListNestedAttribute{
+				MarkdownDescription: "List of time-off types.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: timeOffTypeAttributes,
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier derived from a hash of the arguments and the returned time-off types. It only changes when either of them changes.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *TimeOffTypesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*adapter.PersonioAdapter)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *adapter.PersonioAdapter, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *TimeOffTypesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data TimeOffTypesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeOffTypes, err := d.client.GetTimeOffTypes(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read time-off types, got error: %s", err))
+		return
+	}
+
+	data.TimeOffTypes = timeOffTypes
+	data.Id = utils.GetStableId("personio_time_off_types", data)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
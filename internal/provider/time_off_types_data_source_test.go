@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/jesse0michael/go-rest-assured/assured"
+)
+
+const testAccTimeOffTypesDataSourceConfig = `
+data "personio_time_off_types" "test" {
+}
+`
+
+func TestAccTimeOffTypesDataSource(t *testing.T) {
+	timeOffTypes, _ := os.ReadFile("../../test/data/time_off_types.json")
+	c := DefaultRestServerWith(assured.Call{
+		Path:       "/company/time-off-types",
+		Method:     "GET",
+		StatusCode: 200,
+		Response:   timeOffTypes,
+	})
+	defer c.Close()
+	t.Setenv("PERSONIO_API_URL", c.URL())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccTimeOffTypesDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.personio_time_off_types.test", "time_off_types.#", "5"),
+					resource.TestCheckResourceAttr("data.personio_time_off_types.test", "time_off_types.0.id", "2179197"),
+					resource.TestCheckResourceAttr("data.personio_time_off_types.test", "time_off_types.0.name", "Paid vacation"),
+					resource.TestCheckResourceAttr("data.personio_time_off_types.test", "time_off_types.0.category", "paid_vacation"),
+					resource.TestCheckResourceAttr("data.personio_time_off_types.test", "time_off_types.0.unit", "day"),
+					resource.TestCheckResourceAttr("data.personio_time_off_types.test", "time_off_types.0.half_days_enabled", "true"),
+					resource.TestCheckResourceAttr("data.personio_time_off_types.test", "time_off_types.0.approval_required", "true"),
+					resource.TestCheckResourceAttr("data.personio_time_off_types.test", "time_off_types.3.unit", "hour"),
+					resource.TestCheckResourceAttr("data.personio_time_off_types.test", "time_off_types.3.half_days_enabled", "false"),
+					resource.TestCheckNoResourceAttr("data.personio_time_off_types.test", "time_off_types.4.category"),
+					testCheckQueryParameterSent(c, "company/time-off-types", "limit", "100"),
+				),
+			},
+		},
+	})
+}
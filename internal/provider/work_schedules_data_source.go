@@ -0,0 +1,114 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/nicoangelo/terraform-provider-personio/internal/adapter"
+	"github.com/nicoangelo/terraform-provider-personio/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var (
+	_ datasource.DataSource = &WorkSchedulesDataSource{}
+)
+
+func NewWorkSchedulesDataSource() datasource.DataSource {
+	return &WorkSchedulesDataSource{}
+}
+
+// WorkSchedulesDataSource defines the data source implementation.
+type WorkSchedulesDataSource struct {
+	client *adapter.PersonioAdapter
+}
+
+// WorkSchedulesDataSourceModel describes the data source data model.
+type WorkSchedulesDataSourceModel struct {
+	WorkSchedules []adapter.WorkScheduleSummary `tfsdk:"work_schedules"`
+	Status        []types.String                `tfsdk:"status"`
+	Id            types.String                  `tfsdk:"id"`
+}
+
+func (d *WorkSchedulesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_work_schedules"
+}
+
+func (d *WorkSchedulesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: `
+Work schedules data source
+
+Retrieves all work schedules with their working hours and employees. The Personio API has no endpoint for
+work schedules, so they are derived from the work schedule of each employee: work schedules without employees
+are not returned, and the work schedule attribute must be readable by the API credential.
+
+The working hours of each weekday are converted from HH:MM to decimal hours, e.g. ` + "`7.5`" + ` for 07:30.
+
+The work schedules are ordered by ID.
+`,
+		Attributes: map[string]schema.Attribute{
+			"work_schedules": schema.ListNestedAttribute{
+				MarkdownDescription: "List of work schedules.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: workScheduleSummaryAttributes,
+				},
+			},
+			"status": employeeStatusFilter("Only count employees with one of these statuses"),
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier derived from a hash of the arguments and the returned work schedules. It only changes when either of them changes.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *WorkSchedulesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*adapter.PersonioAdapter)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *adapter.PersonioAdapter, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *WorkSchedulesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data WorkSchedulesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var filter adapter.EmployeeFilter
+	for _, s := range data.Status {
+		filter.Statuses = append(filter.Statuses, s.ValueString())
+	}
+
+	schedules, err := d.client.GetWorkSchedules(ctx, filter)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read work schedules, got error: %s", err))
+		return
+	}
+
+	data.WorkSchedules = schedules
+	data.Id = utils.GetStableId("personio_work_schedules", data)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,71 @@
+package provider
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/jesse0michael/go-rest-assured/assured"
+)
+
+const (
+	testAccWorkSchedulesDataSourceConfig = `
+data "personio_work_schedules" "test" {
+}
+`
+	testAccWorkSchedulesByStatusDataSourceConfig = `
+data "personio_work_schedules" "test" {
+	status = ["leave"]
+}
+`
+)
+
+func TestAccWorkSchedulesDataSource(t *testing.T) {
+	emps, _ := os.ReadFile("../../test/data/schedule_employees.json")
+	c := DefaultRestServerWith(assured.Call{
+		Path:       "/company/employees",
+		Method:     "GET",
+		StatusCode: 200,
+		Response:   emps,
+	})
+	defer c.Close()
+	t.Setenv("PERSONIO_API_URL", c.URL())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccWorkSchedulesDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.personio_work_schedules.test", "work_schedules.#", "2"),
+					resource.TestCheckResourceAttr("data.personio_work_schedules.test", "work_schedules.0.id", "1067807102794692000"),
+					resource.TestCheckResourceAttr("data.personio_work_schedules.test", "work_schedules.0.name", "Full-time, 40 hours without time tracking, (mon,tue,wed,thu,fri)"),
+					resource.TestCheckNoResourceAttr("data.personio_work_schedules.test", "work_schedules.0.valid_from"),
+					resource.TestCheckResourceAttr("data.personio_work_schedules.test", "work_schedules.0.monday", "8"),
+					resource.TestCheckResourceAttr("data.personio_work_schedules.test", "work_schedules.0.sunday", "0"),
+					resource.TestCheckResourceAttr("data.personio_work_schedules.test", "work_schedules.0.weekly_hours", "40"),
+					resource.TestCheckResourceAttr("data.personio_work_schedules.test", "work_schedules.0.headcount", "4"),
+					resource.TestCheckResourceAttr("data.personio_work_schedules.test", "work_schedules.1.id", "1067807102794692002"),
+					resource.TestCheckResourceAttr("data.personio_work_schedules.test", "work_schedules.1.valid_from", "2023-01-01"),
+					resource.TestCheckResourceAttr("data.personio_work_schedules.test", "work_schedules.1.thursday", "7.5"),
+					resource.TestCheckResourceAttr("data.personio_work_schedules.test", "work_schedules.1.friday", "0"),
+					resource.TestCheckResourceAttr("data.personio_work_schedules.test", "work_schedules.1.weekly_hours", "30"),
+					resource.TestCheckResourceAttr("data.personio_work_schedules.test", "work_schedules.1.headcount", "2"),
+					resource.TestCheckResourceAttr("data.personio_work_schedules.test", "work_schedules.1.employee_ids.0", "13649280"),
+					resource.TestCheckResourceAttr("data.personio_work_schedules.test", "work_schedules.1.employee_ids.1", "13649290"),
+				),
+			},
+			{
+				Config: testAccWorkSchedulesByStatusDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.personio_work_schedules.test", "work_schedules.#", "1"),
+					resource.TestCheckResourceAttr("data.personio_work_schedules.test", "work_schedules.0.employee_ids.#", "1"),
+					resource.TestCheckResourceAttr("data.personio_work_schedules.test", "work_schedules.0.employee_ids.0", "13649293"),
+				),
+			},
+		},
+	})
+}
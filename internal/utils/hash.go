@@ -1,12 +1,115 @@
 package utils
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
-	"time"
+	"reflect"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
-func GetUnstableId(name string) types.String {
-	return types.StringValue(fmt.Sprintf("%s-%d", name, time.Now().Unix()))
+// GetStableId returns an identifier for a data source that is derived from
+// the given values, typically the data source model with its arguments and
+// results. The identifier only changes when the values change.
+func GetStableId(name string, values ...any) types.String {
+	return types.StringValue(fmt.Sprintf("%s-%s", name, Hash(values...)))
+}
+
+// Hash returns the hex-encoded SHA-256 hash of the values. Values are hashed
+// in a canonical JSON representation: map keys are sorted, pointers are
+// followed, and framework values are represented by their string value.
+// Struct fields are named by their tfsdk tag, if any, and the fields of
+// exported embedded structs are promoted.
+func Hash(values ...any) string {
+	plains := make([]any, len(values))
+	for i, v := range values {
+		plains[i] = plainValue(reflect.ValueOf(v))
+	}
+	b, err := json.Marshal(plains)
+	if err != nil {
+		// plain values only consist of JSON types
+		panic(fmt.Sprintf("unable to hash values: %s", err))
+	}
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:])
+}
+
+var attrValueType = reflect.TypeOf((*attr.Value)(nil)).Elem()
+
+// plainValue converts v to a value that consists of JSON types only.
+func plainValue(v reflect.Value) any {
+	if !v.IsValid() {
+		return nil
+	}
+	if v.Type().Implements(attrValueType) {
+		if v.Kind() == reflect.Pointer && v.IsNil() {
+			return nil
+		}
+		av := v.Interface().(attr.Value)
+		switch {
+		case av.IsNull():
+			return nil
+		case av.IsUnknown():
+			return map[string]any{"unknown": true}
+		}
+		return av.String()
+	}
+
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+		return plainValue(v.Elem())
+	case reflect.Struct:
+		res := map[string]any{}
+		for i := 0; i < v.NumField(); i++ {
+			f := v.Type().Field(i)
+			if !f.IsExported() {
+				continue
+			}
+			tag, _, _ := strings.Cut(f.Tag.Get("tfsdk"), ",")
+			if f.Anonymous && tag == "" {
+				// promote the fields of embedded structs, like tfsdk does
+				if embedded, ok := plainValue(v.Field(i)).(map[string]any); ok {
+					for k, fv := range embedded {
+						res[k] = fv
+					}
+					continue
+				}
+			}
+			name := f.Name
+			if tag != "" {
+				name = tag
+			}
+			res[name] = plainValue(v.Field(i))
+		}
+		return res
+	case reflect.Slice, reflect.Array:
+		if v.Kind() == reflect.Slice && v.IsNil() {
+			return nil
+		}
+		res := make([]any, v.Len())
+		for i := range res {
+			res[i] = plainValue(v.Index(i))
+		}
+		return res
+	case reflect.Map:
+		if v.IsNil() {
+			return nil
+		}
+		res := make(map[string]any, v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			res[fmt.Sprint(iter.Key().Interface())] = plainValue(iter.Value())
+		}
+		return res
+	case reflect.Func, reflect.Chan, reflect.UnsafePointer:
+		return nil
+	}
+	return v.Interface()
 }
@@ -0,0 +1,60 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type hashModel struct {
+	Id     types.String            `tfsdk:"id"`
+	Values map[string]types.String `tfsdk:"values"`
+	Nested *hashModel              `tfsdk:"nested"`
+}
+
+func TestHashIsStable(t *testing.T) {
+	a := hashModel{
+		Values: map[string]types.String{"a": types.StringValue("1"), "b": types.StringValue("2"), "c": types.StringNull()},
+		Nested: &hashModel{Id: types.StringValue("nested")},
+	}
+	b := hashModel{
+		Values: map[string]types.String{"c": types.StringNull(), "b": types.StringValue("2"), "a": types.StringValue("1")},
+		Nested: &hashModel{Id: types.StringValue("nested")},
+	}
+	if Hash(a) != Hash(b) {
+		t.Errorf("expected equal values to have the same hash")
+	}
+
+	b.Nested.Id = types.StringValue("changed")
+	if Hash(a) == Hash(b) {
+		t.Errorf("expected a changed nested value to change the hash")
+	}
+}
+
+func TestHashDistinguishesNullAndEmpty(t *testing.T) {
+	if Hash(types.StringNull()) == Hash(types.StringValue("")) {
+		t.Errorf("expected null and empty string to have different hashes")
+	}
+}
+
+// EmbeddedModel is exported, as only the fields of exported
+// embedded structs can be read.
+type EmbeddedModel struct {
+	Id types.String `tfsdk:"id"`
+}
+
+type embeddingModel struct {
+	EmbeddedModel
+	Extra types.String `tfsdk:"extra"`
+}
+
+func TestHashPromotesEmbeddedFields(t *testing.T) {
+	flat := struct {
+		Id    types.String `tfsdk:"id"`
+		Extra types.String `tfsdk:"extra"`
+	}{Id: types.StringValue("a"), Extra: types.StringValue("b")}
+	embedded := embeddingModel{EmbeddedModel: EmbeddedModel{Id: types.StringValue("a")}, Extra: types.StringValue("b")}
+	if Hash(flat) != Hash(embedded) {
+		t.Errorf("expected embedded fields to be hashed like fields of the struct itself")
+	}
+}
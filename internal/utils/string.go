@@ -1,5 +1,7 @@
 package utils
 
+import "strings"
+
 // coalesceEmpty returns the first non-empty value given
 // in the arguments. If none is found, an empty string is returned.
 func CoalesceEmpty(values ...string) string {
@@ -10,3 +12,13 @@ func CoalesceEmpty(values ...string) string {
 	}
 	return ""
 }
+
+// QuoteJoin formats the values as a comma-separated list of
+// Markdown inline code, e.g. for use in attribute descriptions.
+func QuoteJoin(values []string) string {
+	quoted := make([]string, len(values))
+	for i, v := range values {
+		quoted[i] = "`" + v + "`"
+	}
+	return strings.Join(quoted, ", ")
+}
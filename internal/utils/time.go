@@ -0,0 +1,20 @@
+package utils
+
+import (
+	"fmt"
+	"time"
+)
+
+const DateFormat = "2006-01-02"
+
+// ParseTimeOrDate parses an RFC3339 timestamp or a date in
+// YYYY-MM-DD format. Dates are interpreted as midnight in UTC.
+func ParseTimeOrDate(value string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	if t, err := time.Parse(DateFormat, value); err == nil {
+		return t, nil
+	}
+	return time.Time{}, fmt.Errorf("%q is neither an RFC3339 timestamp nor a date in YYYY-MM-DD format", value)
+}